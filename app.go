@@ -7,27 +7,35 @@ import (
 	"github.com/NARUBROWN/spine/internal/bootstrap"
 	"github.com/NARUBROWN/spine/internal/event/consumer"
 	"github.com/NARUBROWN/spine/internal/router"
+	"github.com/NARUBROWN/spine/internal/scheduler"
 	"github.com/NARUBROWN/spine/internal/ws"
 	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/schedule"
 )
 
 type App interface {
-	// 생성자 선언
+	// 생성자 선언 (di.Scoped / di.Transient로 수명을 지정할 수 있습니다)
 	Constructor(constructors ...any)
 	// 라우트 선언
 	Route(method string, path string, handler any, opts ...router.RouteOption)
+	// 공통 prefix와 라우트 옵션(Interceptor 등)을 공유하는 라우트 그룹 선언
+	Group(prefix string, opts ...router.RouteOption) RouteGroup
 	// 인터셉터 선언
 	Interceptor(interceptors ...core.Interceptor)
 	// HTTP Transport 확장 (Echo 등)
 	Transport(fn func(any))
 	// 독립 실행되는 Custom Transport 등록
 	RegisterTransport(t core.CustomTransport)
+	// cron 표현식(5/6필드, @every 등)으로 주기 실행할 작업 선언
+	Schedule(spec string, handler any, opts ...schedule.Option)
 	// 실행
 	Run(opts boot.Options) error
 	// 이벤트 소비자 레지스트리 반환
 	Consumers() *consumer.Registry
 	// 웹 소켓 레지스트리 반환
 	WebSocket() *ws.Registry
+	// 선언된 라우트로 OpenAPI 3.1 문서(JSON) 생성 (GlobalPrefix, OpenAPI 정보 반영)
+	OpenAPI(opts boot.HTTPOptions) ([]byte, error)
 }
 
 type app struct {
@@ -38,6 +46,7 @@ type app struct {
 	customTransports  []core.CustomTransport
 	consumerRegistry  *consumer.Registry
 	websocketRegistry *ws.Registry
+	jobs              []scheduler.JobSpec
 }
 
 func New() App {
@@ -77,6 +86,14 @@ func (a *app) RegisterTransport(t core.CustomTransport) {
 	a.customTransports = append(a.customTransports, t)
 }
 
+func (a *app) Schedule(spec string, handler any, opts ...schedule.Option) {
+	a.jobs = append(a.jobs, scheduler.JobSpec{
+		Spec:    spec,
+		Handler: handler,
+		Options: opts,
+	})
+}
+
 func (a *app) Run(opts boot.Options) error {
 	internalConfig := bootstrap.Config{
 		Address:                opts.Address,
@@ -89,14 +106,22 @@ func (a *app) Run(opts boot.Options) error {
 		ShutdownTimeout:        opts.ShutdownTimeout,
 		Kafka:                  opts.Kafka,
 		RabbitMQ:               opts.RabbitMQ,
+		InMemory:               opts.InMemory,
+		Codecs:                 opts.Codecs,
 		ConsumerRegistry:       a.consumerRegistry,
 		WebSocketRegistry:      a.websocketRegistry,
 		HTTP:                   opts.HTTP,
+		Outbox:                 opts.Outbox,
+		Jobs:                   a.jobs,
 	}
 
 	return bootstrap.Run(internalConfig)
 }
 
+func (a *app) OpenAPI(opts boot.HTTPOptions) ([]byte, error) {
+	return bootstrap.GenerateOpenAPI(a.routes, opts)
+}
+
 func (a *app) Consumers() *consumer.Registry {
 	if a.consumerRegistry == nil {
 		a.consumerRegistry = consumer.NewRegistry()
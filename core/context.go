@@ -3,6 +3,8 @@ package core
 import (
 	"context"
 	"mime/multipart"
+
+	"github.com/NARUBROWN/spine/pkg/event"
 )
 
 type ContextCarrier interface {
@@ -32,6 +34,17 @@ type ExecutionContext interface {
 	Get(key string) (any, bool)
 }
 
+/*
+RequestValuesCarrier
+- 경로 파라미터, 쿼리, 헤더 전체 뷰
+- HTTP 요청과 WebSocket 핸드셰이크가 함께 제공
+*/
+type RequestValuesCarrier interface {
+	Params() map[string]string
+	Queries() map[string][]string
+	Headers() map[string][]string
+}
+
 /*
 ControllerContext
 - Controller 전용 Context View
@@ -79,6 +92,14 @@ type ConsumerRequestContext interface {
 	Payload() []byte
 }
 
+/*
+MetadataCarrier
+- 브로커 메타데이터(헤더, key, offset, message ID 등)를 제공하는 Consumer Context
+*/
+type MetadataCarrier interface {
+	Metadata() event.Metadata
+}
+
 /*
 WebSocketContext
 - WebSocket 전용 ExecutionContext 확장
@@ -8,6 +8,7 @@ require (
 	github.com/labstack/gommon v0.4.2
 	github.com/rabbitmq/amqp091-go v1.10.0
 	github.com/segmentio/kafka-go v0.4.50
+	google.golang.org/protobuf v1.36.11
 )
 
 require (
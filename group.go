@@ -0,0 +1,86 @@
+package spine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/NARUBROWN/spine/internal/bootstrap"
+	"github.com/NARUBROWN/spine/internal/router"
+)
+
+/*
+RouteGroup은 공통 prefix와 라우트 옵션을 공유하는 라우트 묶음입니다.
+그룹 옵션은 라우트 옵션보다 먼저 적용되므로, Interceptor는 바깥 그룹 → 안쪽 그룹 → 라우트 순으로 실행됩니다.
+
+	admin := app.Group("/admin", route.WithInterceptors(auth, audit))
+	admin.Route("GET", "/users", (*AdminController).ListUsers)
+
+	reports := admin.Group("/reports", route.WithTags("reports"))
+	reports.Route("GET", "/:id", (*ReportController).Get) // GET /admin/reports/:id
+
+prefix는 "/"로 시작해야 하며, 그렇지 않으면 선언 시점에 panic 합니다.
+prefix에도 경로 파라미터와 제약 조건을 쓸 수 있습니다. (예: /tenants/:tenant<[a-z]+>)
+*/
+type RouteGroup interface {
+	// 그룹 prefix 아래에 라우트 선언 (빈 경로나 "/"는 prefix 자체)
+	Route(method string, path string, handler any, opts ...router.RouteOption)
+	// 현재 그룹의 prefix와 옵션을 물려받는 하위 그룹 생성
+	Group(prefix string, opts ...router.RouteOption) RouteGroup
+}
+
+type routeGroup struct {
+	app    *app
+	prefix string
+	opts   []router.RouteOption
+}
+
+func (a *app) Group(prefix string, opts ...router.RouteOption) RouteGroup {
+	return &routeGroup{
+		app:    a,
+		prefix: groupPrefix("", prefix),
+		opts:   append([]router.RouteOption(nil), opts...),
+	}
+}
+
+func (g *routeGroup) Route(method string, path string, handler any, opts ...router.RouteOption) {
+	combined := make([]router.RouteOption, 0, len(g.opts)+len(opts))
+	combined = append(combined, g.opts...)
+	combined = append(combined, opts...)
+
+	fullPath := g.prefix
+	if path != "" && path != "/" {
+		// 빈 경로가 아니므로 에러가 발생하지 않는다.
+		fullPath, _ = bootstrap.JoinPath(g.prefix, path)
+	}
+	if fullPath == "" {
+		fullPath = "/"
+	}
+	g.app.Route(method, fullPath, handler, combined...)
+}
+
+func (g *routeGroup) Group(prefix string, opts ...router.RouteOption) RouteGroup {
+	combined := make([]router.RouteOption, 0, len(g.opts)+len(opts))
+	combined = append(combined, g.opts...)
+	combined = append(combined, opts...)
+
+	return &routeGroup{
+		app:    g.app,
+		prefix: groupPrefix(g.prefix, prefix),
+		opts:   combined,
+	}
+}
+
+// groupPrefix는 prefix를 검증한 뒤 상위 그룹 prefix와 이어 붙입니다. 루트 그룹의 prefix는 빈 문자열입니다.
+// GlobalPrefix 결합과 모호성 검사는 bootstrap에서 전체 경로 기준으로 수행됩니다.
+func groupPrefix(parent, prefix string) string {
+	if prefix != "" && !strings.HasPrefix(prefix, "/") {
+		panic(fmt.Sprintf("route group prefix must start with '/': %q", prefix))
+	}
+
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" {
+		return parent
+	}
+	joined, _ := bootstrap.JoinPath(parent, prefix)
+	return joined
+}
@@ -142,6 +142,11 @@ func (s *Server) handle(c echo.Context) error {
 		c.SetRequest(req)
 	}
 
+	// GET 핸들러로 응답하는 HEAD 요청은 헤더만 내보내고 본문은 버린다.
+	if c.Request().Method == http.MethodHead {
+		c.Response().Writer = headResponseWriter{ResponseWriter: c.Response().Writer}
+	}
+
 	ctx := NewContext(c)
 
 	ctx.Set(
@@ -160,3 +165,12 @@ func (s *Server) handle(c echo.Context) error {
 func (s *Server) Shutdown(ctx context.Context) error {
 	return s.httpServer.Shutdown(ctx)
 }
+
+// headResponseWriter는 상태 코드와 헤더는 그대로 쓰고 본문 쓰기만 무시합니다.
+type headResponseWriter struct {
+	http.ResponseWriter
+}
+
+func (w headResponseWriter) Write(p []byte) (int, error) {
+	return len(p), nil
+}
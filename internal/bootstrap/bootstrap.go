@@ -17,19 +17,28 @@ import (
 	httpEngine "github.com/NARUBROWN/spine/internal/adapter/echo"
 	"github.com/NARUBROWN/spine/internal/container"
 	"github.com/NARUBROWN/spine/internal/event/consumer"
+	eventHandler "github.com/NARUBROWN/spine/internal/event/consumer/handler"
 	eventResolver "github.com/NARUBROWN/spine/internal/event/consumer/resolver"
 	"github.com/NARUBROWN/spine/internal/event/hook"
+	"github.com/NARUBROWN/spine/internal/event/infra/inmemory"
 	"github.com/NARUBROWN/spine/internal/event/infra/kafka"
 	"github.com/NARUBROWN/spine/internal/event/infra/rabbitmq"
+	eventOutbox "github.com/NARUBROWN/spine/internal/event/outbox"
 	eventPublish "github.com/NARUBROWN/spine/internal/event/publish"
 	"github.com/NARUBROWN/spine/internal/handler"
 	"github.com/NARUBROWN/spine/internal/invoker"
+	"github.com/NARUBROWN/spine/internal/openapi"
 	"github.com/NARUBROWN/spine/internal/pipeline"
 	"github.com/NARUBROWN/spine/internal/resolver"
 	spineRouter "github.com/NARUBROWN/spine/internal/router"
+	"github.com/NARUBROWN/spine/internal/scheduler"
 	"github.com/NARUBROWN/spine/internal/ws"
-	wsResolver "github.com/NARUBROWN/spine/internal/ws/resolver"
 	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/di"
+	"github.com/NARUBROWN/spine/pkg/event/codec"
+	"github.com/NARUBROWN/spine/pkg/event/outbox"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+	pkgws "github.com/NARUBROWN/spine/pkg/ws"
 	"github.com/labstack/echo/v4"
 )
 
@@ -44,9 +53,13 @@ type Config struct {
 	ShutdownTimeout        time.Duration
 	Kafka                  *boot.KafkaOptions
 	RabbitMQ               *boot.RabbitMqOptions
+	InMemory               *boot.InMemoryOptions
+	Codecs                 *codec.Registry
 	ConsumerRegistry       *consumer.Registry
 	HTTP                   *boot.HTTPOptions
 	WebSocketRegistry      *ws.Registry
+	Outbox                 *boot.OutboxOptions
+	Jobs                   []scheduler.JobSpec
 }
 
 type containerFacade struct {
@@ -77,41 +90,71 @@ func Run(config Config) error {
 	log.Printf("[Bootstrap] Registering constructors (%d)", len(config.Constructors))
 	// 생성자 등록 (HTTP/Consumer 공통)
 	for _, constructor := range config.Constructors {
-		log.Printf("[Bootstrap] Registering constructor: %T", constructor)
+		if provider, ok := constructor.(di.Provider); ok {
+			log.Printf("[Bootstrap] Registering %s constructor: %T", provider.Lifetime, provider.Constructor)
+		} else {
+			log.Printf("[Bootstrap] Registering constructor: %T", constructor)
+		}
 		if err := container.RegisterConstructor(constructor); err != nil {
 			return err
 		}
 	}
 
+	// 실행 스코프가 직접 제공하는 값 (Scoped 생성자에서 주입 가능)
+	container.RegisterScopedValue(reflect.TypeFor[core.ControllerContext]())
+	container.RegisterScopedValue(reflect.TypeFor[context.Context]())
+
 	// 이벤트 발행기 모음 (Kafka/RabbitMQ 등 옵션에 따라 채워짐)
 	var eventPublishers []eventPublish.EventPublisher
 
 	// Kafka Write 옵션이 존재하면 Publisher 구성
+	var kafkaPublisher *kafka.KafkaPublisher
+	var kafkaWriteOptions boot.KafkaOptions
 	if config.Kafka != nil && config.Kafka.Write != nil {
 		log.Println("[Bootstrap] Configuring Kafka publisher")
 
-		kafkaPublisher, err := kafka.NewKafkaPublisher(&boot.KafkaOptions{
+		kafkaWriteOptions = boot.KafkaOptions{
 			Brokers: config.Kafka.Brokers,
 			Write: &boot.KafkaWriteOptions{
-				TopicPrefix: config.Kafka.Write.TopicPrefix,
+				TopicPrefix:  config.Kafka.Write.TopicPrefix,
+				DelayTopic:   config.Kafka.Write.DelayTopic,
+				DelayGroupID: config.Kafka.Write.DelayGroupID,
+				ReplyTopic:   config.Kafka.Write.ReplyTopic,
+				ReplyGroupID: config.Kafka.Write.ReplyGroupID,
 			},
-		})
+		}
+		var err error
+		kafkaPublisher, err = kafka.NewKafkaPublisher(&kafkaWriteOptions)
 		if err != nil {
 			return fmt.Errorf("[Bootstrap] failed to initialize Kafka publisher: %w", err)
 		}
+		kafkaPublisher.SetCodecs(config.Codecs)
 		eventPublishers = append(eventPublishers, kafkaPublisher)
 		defer func() {
 			if err := kafkaPublisher.Close(); err != nil {
 				log.Printf("[Bootstrap] failed to close Kafka publisher: %v", err)
 			}
 		}()
+
+		// 지연 Topic이 지정되어 있으면 전달 시각이 된 메시지를 원래 Topic으로 옮기는 relay 실행
+		if config.Kafka.Write.DelayTopic != "" {
+			log.Printf("[Bootstrap] Starting Kafka delay relay (topic=%s)", config.Kafka.Write.DelayTopic)
+			delayRelay, err := kafka.NewDelayRelay(kafkaWriteOptions)
+			if err != nil {
+				return fmt.Errorf("[Bootstrap] failed to initialize Kafka delay relay: %w", err)
+			}
+			delayRelay.Start(context.Background())
+			defer delayRelay.Stop()
+		}
 	}
 
 	// RabbitMQ Write 옵션이 존재하면 Publisher 구성
+	var rabbitmqWriter *rabbitmq.Writer
 	if config.RabbitMQ != nil && config.RabbitMQ.Write != nil {
 		log.Println("[Bootstrap] Configuring RabbitMQ publisher")
 
-		rabbitmqWriter, err := rabbitmq.NewRabbitMqWriter(boot.RabbitMqOptions{
+		var err error
+		rabbitmqWriter, err = rabbitmq.NewRabbitMqWriter(boot.RabbitMqOptions{
 			URL: config.RabbitMQ.URL,
 			Write: &boot.RabbitMqWriteOptions{
 				Exchange: config.RabbitMQ.Write.Exchange,
@@ -120,6 +163,7 @@ func Run(config Config) error {
 		if err != nil {
 			return fmt.Errorf("[Bootstrap] failed to initialize RabbitMQ writer: %w", err)
 		}
+		rabbitmqWriter.SetCodecs(config.Codecs)
 		eventPublishers = append(eventPublishers, rabbitmqWriter)
 		defer func() {
 			if err := rabbitmqWriter.Close(); err != nil {
@@ -128,10 +172,87 @@ func Run(config Config) error {
 		}()
 	}
 
+	// In-Memory 옵션이 존재하면 프로세스 내부 브로커를 Publisher로 구성
+	var inMemoryBroker *inmemory.Broker
+	if config.InMemory != nil {
+		log.Println("[Bootstrap] Configuring in-memory event broker")
+
+		inMemoryBroker = inmemory.NewBroker(*config.InMemory)
+		inMemoryBroker.SetCodecs(config.Codecs)
+		eventPublishers = append(eventPublishers, inMemoryBroker)
+
+		// HTTP 서버가 컨슈머보다 먼저 뜨므로, 그 사이 발행된 이벤트도 받도록 구독을 먼저 만든다.
+		if config.ConsumerRegistry != nil {
+			for _, reg := range config.ConsumerRegistry.Registrations() {
+				if err := inMemoryBroker.Subscribe(reg.Topic, config.InMemory.GroupID); err != nil {
+					return fmt.Errorf("[Bootstrap] failed to subscribe in-memory topic: %w", err)
+				}
+			}
+		}
+		defer func() {
+			if err := inMemoryBroker.Close(); err != nil {
+				log.Printf("[Bootstrap] failed to close in-memory broker: %v", err)
+			}
+		}()
+	}
+
+	// 요청/응답: 응답을 받을 수 있는 브로커 하나(RabbitMQ, Kafka, In-Memory 순)로 요청을 보낸다.
+	replies := eventPublish.NewReplyRouter()
+	var requestPublisher eventPublish.EventPublisher
+	var replyTo string
+	switch {
+	case rabbitmqWriter != nil:
+		if err := rabbitmqWriter.ConsumeReplies(replies); err != nil {
+			return fmt.Errorf("[Bootstrap] failed to initialize RabbitMQ reply consumer: %w", err)
+		}
+		requestPublisher, replyTo = rabbitmqWriter, rabbitmq.DirectReplyTo
+		log.Println("[Bootstrap] Requests are sent over RabbitMQ (direct reply-to)")
+
+	case kafkaPublisher != nil && kafkaWriteOptions.Write.ReplyTopic != "":
+		replyListener, err := kafka.NewReplyListener(kafkaWriteOptions, replies)
+		if err != nil {
+			return fmt.Errorf("[Bootstrap] failed to initialize Kafka reply listener: %w", err)
+		}
+		replyListener.Start(context.Background())
+		defer replyListener.Stop()
+		requestPublisher, replyTo = kafkaPublisher, kafkaWriteOptions.Write.ReplyTopic
+		log.Printf("[Bootstrap] Requests are sent over Kafka (reply topic=%s)", kafkaWriteOptions.Write.ReplyTopic)
+
+	case inMemoryBroker != nil:
+		replyListener, err := inmemory.NewReplyListener(inMemoryBroker, replies)
+		if err != nil {
+			return fmt.Errorf("[Bootstrap] failed to initialize in-memory reply listener: %w", err)
+		}
+		replyListener.Start(context.Background())
+		defer replyListener.Stop()
+		requestPublisher, replyTo = inMemoryBroker, inmemory.ReplyTopic
+		log.Println("[Bootstrap] Requests are sent over the in-memory broker")
+	}
+	if requestPublisher != nil {
+		requester, err := eventPublish.NewRequester(requestPublisher, replyTo, replies)
+		if err != nil {
+			return fmt.Errorf("[Bootstrap] failed to initialize requester: %w", err)
+		}
+		if err := container.RegisterConstructor(func() publish.Requester { return requester }); err != nil {
+			return err
+		}
+	}
+
+	// WebSocket Hub: HTTP Controller, Event Consumer에서도 주입받아 연결에 메시지를 보낼 수 있게 먼저 등록한다.
+	var wsHub *ws.Hub
+	if config.HTTP != nil && config.WebSocketRegistry != nil && len(config.WebSocketRegistry.Registrations()) > 0 {
+		wsHub = ws.NewHub()
+		if err := container.RegisterConstructor(func() pkgws.Hub { return wsHub }); err != nil {
+			return err
+		}
+	}
+
 	// PostExecutionHook에서 사용할 공통 Dispatcher (Publishers가 없으면 nil 유지)
-	var dispatchHook *hook.EventDispatchHook
+	var dispatcher *eventPublish.DefaultEventDispatcher
+	var dispatchHook hook.PostExecutionHook
 	if len(eventPublishers) > 0 {
-		dispatcher, err := eventPublish.NewDefaultEventDispatcher(eventPublishers...)
+		var err error
+		dispatcher, err = eventPublish.NewDefaultEventDispatcher(eventPublishers...)
 		if err != nil {
 			return fmt.Errorf("[Bootstrap] failed to initialize event dispatcher: %w", err)
 		}
@@ -140,6 +261,68 @@ func Run(config Config) error {
 		}
 	}
 
+	// Outbox 모드: 이벤트는 Store에 기록하고, relay가 Dispatcher로 발행한다.
+	var outboxStore outbox.Store
+	if config.Outbox != nil {
+		if dispatcher == nil {
+			return fmt.Errorf("[Bootstrap] outbox requires an event publisher (Kafka/RabbitMQ Write options or InMemory)")
+		}
+
+		log.Println("[Bootstrap] Configuring transactional outbox")
+		store, err := container.Resolve(reflect.TypeFor[outbox.Store]())
+		if err != nil {
+			return fmt.Errorf("[Bootstrap] outbox requires an outbox.Store constructor: %w", err)
+		}
+		outboxStore = store.(outbox.Store)
+
+		// Scoped *outbox.Tx가 있으면 이벤트를 요청 트랜잭션 안에서 기록한다.
+		// SQLStore를 트랜잭션 없이 쓰면 도메인 변경과 따로 커밋되므로 기동을 거부한다.
+		scopedTx := false
+		if lifetime, err := container.Lifetime(reflect.TypeFor[*outbox.Tx]()); err == nil {
+			if lifetime != di.LifetimeScoped {
+				return fmt.Errorf("[Bootstrap] *outbox.Tx must be registered as Scoped (di.Scoped(outbox.NewTx)), got %s", lifetime)
+			}
+			scopedTx = true
+		}
+		if _, sqlStore := outboxStore.(*outbox.SQLStore); sqlStore && !scopedTx {
+			return fmt.Errorf("[Bootstrap] outbox.SQLStore requires a Scoped *outbox.Tx constructor (di.Scoped(outbox.NewTx)) to record events in the request transaction")
+		}
+		dispatchHook = &hook.OutboxHook{
+			Store:    outboxStore,
+			ScopedTx: scopedTx,
+			Codecs:   config.Codecs,
+		}
+	}
+
+	// 컴포넌트 라이프사이클 (Starter / Stopper)
+	// 트랜스포트보다 먼저 시작하고, 트랜스포트가 모두 멈춘 뒤 퍼블리셔보다 먼저 정리된다.
+	lifecycle := newComponentLifecycle(container)
+	defer func() {
+		timeout := config.ShutdownTimeout
+		if timeout == 0 {
+			timeout = 10 * time.Second
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		lifecycle.stop(ctx)
+	}()
+
+	log.Println("[Bootstrap] Starting lifecycle components")
+	if err := lifecycle.start(context.Background()); err != nil {
+		return err
+	}
+
+	// Outbox relay는 컴포넌트(DB 등)가 시작된 뒤 시작하고, 트랜스포트가 모두 멈춘 뒤 멈춘다.
+	if outboxStore != nil {
+		relay := eventOutbox.NewRelay(outboxStore, dispatcher, *config.Outbox)
+		log.Println("[Bootstrap] Starting outbox relay")
+		relay.Start(context.Background())
+		defer func() {
+			log.Println("[Bootstrap] Stopping outbox relay")
+			relay.Stop()
+		}()
+	}
+
 	var server *httpEngine.Server
 	var httpErrCh chan error
 	var consumerErrCh chan error
@@ -194,18 +377,11 @@ func Run(config Config) error {
 	}
 
 	if config.HTTP != nil {
-		prefix := config.HTTP.GlobalPrefix
+		prefix, err := normalizeGlobalPrefix(config.HTTP.GlobalPrefix)
+		if err != nil {
+			return err
+		}
 		if prefix != "" {
-			if !strings.HasPrefix(prefix, "/") {
-				return fmt.Errorf("HTTP global prefix must start with '/'")
-			}
-			if strings.Contains(prefix, ":") {
-				return fmt.Errorf("path parameters are not allowed in the HTTP global prefix")
-			}
-			if strings.Contains(prefix, "*") {
-				return fmt.Errorf("wildcards are not allowed in the HTTP global prefix")
-			}
-			prefix = strings.TrimSuffix(prefix, "/")
 			log.Printf("[Bootstrap] Applied HTTP global prefix: %s", prefix)
 		}
 
@@ -255,7 +431,7 @@ func Run(config Config) error {
 			}
 
 			meta.Interceptors = resolved
-			fullPath, err := joinPath(prefix, route.Path)
+			fullPath, err := JoinPath(prefix, route.Path)
 			if err != nil {
 				return err
 			}
@@ -287,7 +463,7 @@ func Run(config Config) error {
 		log.Println("[Bootstrap] Registering argument resolvers")
 		httpPipeline.AddArgumentResolver(
 			// 표준 Context 리졸버
-			&resolver.StdContextResolver{},
+			&resolver.StdContextResolver{Codecs: config.Codecs},
 
 			// Spine Controller Context View
 			&resolver.ControllerContextResolver{},
@@ -303,6 +479,7 @@ func Run(config Config) error {
 			// Query 의미 타입 리졸버들
 			&resolver.PaginationResolver{},
 			&resolver.QueryValuesResolver{},
+			&resolver.QueryDTOResolver{},
 
 			// Body 리졸버
 			&resolver.DTOResolver{},
@@ -369,9 +546,13 @@ func Run(config Config) error {
 			log.Printf("[Bootstrap] Configuring WebSocket routes (%d routes)", len(wsRegistrations))
 
 			// WS 전용 ArgumentResolver 등록
-			wsPipeline := buildWSPipeline(container, config.WebSocketRegistry, dispatchHook)
+			wsPipeline, err := buildWSPipeline(container, config.WebSocketRegistry, dispatchHook, config.Codecs)
+			if err != nil {
+				return err
+			}
 
 			wsRuntime = ws.NewRuntime(config.WebSocketRegistry, wsPipeline, config.HTTP.WebSocket)
+			wsRuntime.SetHub(wsHub)
 			defer wsRuntime.Stop()
 
 			// Echo Transport Hook으로 마운트
@@ -391,6 +572,30 @@ func Run(config Config) error {
 			config.TransportHooks = append([]func(any){wsMountHook}, config.TransportHooks...)
 		}
 
+		// OpenAPI 문서 제공
+		if config.HTTP.OpenAPI != nil {
+			document, err := GenerateOpenAPI(config.Routes, *config.HTTP)
+			if err != nil {
+				return fmt.Errorf("[Bootstrap] OpenAPI document generation failed: %w", err)
+			}
+
+			docPath := config.HTTP.OpenAPI.Path
+			if docPath == "" {
+				docPath = "/openapi.json"
+			}
+			openAPIMountHook := func(e any) {
+				echoInstance, ok := e.(*echo.Echo)
+				if !ok {
+					return
+				}
+				log.Printf("[Bootstrap] Serving OpenAPI document: %s", docPath)
+				echoInstance.GET(docPath, func(c echo.Context) error {
+					return c.Blob(http.StatusOK, "application/json", document)
+				})
+			}
+			config.TransportHooks = append([]func(any){openAPIMountHook}, config.TransportHooks...)
+		}
+
 		// Echo Adapter
 		server = httpEngine.NewServer(httpPipeline, config.Address, config.TransportHooks, *config.HTTP)
 		server.Mount()
@@ -435,7 +640,7 @@ func Run(config Config) error {
 			},
 		})
 
-		consumerPipeline := buildConsumerPipeline(container, config.ConsumerRegistry, dispatchHook)
+		consumerPipeline := buildConsumerPipeline(container, config.ConsumerRegistry, dispatchHook, config.Codecs)
 
 		runtime := consumer.NewRuntime(
 			config.ConsumerRegistry,
@@ -467,7 +672,7 @@ func Run(config Config) error {
 			},
 		})
 
-		consumerPipeline := buildConsumerPipeline(container, config.ConsumerRegistry, dispatchHook)
+		consumerPipeline := buildConsumerPipeline(container, config.ConsumerRegistry, dispatchHook, config.Codecs)
 
 		runtime := consumer.NewRuntime(
 			config.ConsumerRegistry,
@@ -485,6 +690,72 @@ func Run(config Config) error {
 		consumerStarted = true
 	}
 
+	// In-Memory 브로커가 구성되어 있으면, 같은 프로세스에서 컨슈머 구성
+	if inMemoryBroker != nil && config.ConsumerRegistry != nil && len(config.ConsumerRegistry.Registrations()) > 0 {
+		log.Println("[Bootstrap] Configuring in-memory consumer")
+		if consumerErrCh == nil {
+			consumerErrCh = make(chan error, 1)
+		}
+
+		factory := inmemory.NewRunnerFactory(inMemoryBroker, *config.InMemory)
+
+		consumerPipeline := buildConsumerPipeline(container, config.ConsumerRegistry, dispatchHook, config.Codecs)
+
+		runtime := consumer.NewRuntime(
+			config.ConsumerRegistry,
+			factory,
+			consumerPipeline,
+		)
+
+		if err := runtime.Validate(); err != nil {
+			return fmt.Errorf("[Bootstrap] in-memory consumer validation failed: %w", err)
+		}
+
+		forwardConsumerErrors("InMemory", runtime, consumerErrCh)
+		go runtime.Start(context.Background())
+		defer runtime.Stop()
+		consumerStarted = true
+	}
+
+	// 예약 작업이 선언되어 있으면 스케줄러 구성
+	schedulerStarted := false
+	if len(config.Jobs) > 0 {
+		log.Printf("[Bootstrap] Configuring scheduled jobs (%d jobs)", len(config.Jobs))
+		jobRegistry := scheduler.NewRegistry()
+		for _, job := range config.Jobs {
+			if err := jobRegistry.Register(job.Spec, job.Handler, job.Options...); err != nil {
+				return fmt.Errorf("[Bootstrap] failed to register scheduled job: %w", err)
+			}
+		}
+
+		var jobTypes []reflect.Type
+		for _, reg := range jobRegistry.Registrations() {
+			log.Printf("[Bootstrap] Registered scheduled job: %s (%s)", reg.Name, reg.Spec)
+			jobTypes = append(jobTypes, reg.Meta.ControllerType)
+		}
+		if err := container.WarmUp(jobTypes); err != nil {
+			return fmt.Errorf("[Bootstrap] scheduled job warm-up failed: %w", err)
+		}
+
+		schedulePipeline, err := buildSchedulePipeline(container, jobRegistry, dispatchHook, config.Codecs)
+		if err != nil {
+			return err
+		}
+
+		schedulerRuntime := scheduler.NewRuntime(jobRegistry, schedulePipeline)
+		schedulerRuntime.Start(context.Background())
+		defer func() {
+			timeout := config.ShutdownTimeout
+			if timeout == 0 {
+				timeout = 10 * time.Second
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+			schedulerRuntime.Stop(ctx)
+		}()
+		schedulerStarted = true
+	}
+
 	if config.HTTP != nil {
 		// Graceful 비활성화: 서버가 종료될 때까지 블록
 		if !config.EnableGracefulShutdown {
@@ -536,8 +807,8 @@ func Run(config Config) error {
 		log.Println("[Bootstrap] Shutdown completed successfully")
 	}
 
-	// HTTP가 비활성화된 상태에서 이벤트 컨슈머만 실행 중이면 종료 신호를 기다린다.
-	if config.HTTP == nil && (consumerStarted || customTransportErrCh != nil) {
+	// HTTP가 비활성화된 상태에서 이벤트 컨슈머나 스케줄러만 실행 중이면 종료 신호를 기다린다.
+	if config.HTTP == nil && (consumerStarted || schedulerStarted || customTransportErrCh != nil) {
 		quit := make(chan os.Signal, 1)
 		signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 		defer signal.Stop(quit)
@@ -562,7 +833,50 @@ func Run(config Config) error {
 	return nil
 }
 
-func joinPath(prefix, path string) (string, error) {
+// normalizeGlobalPrefix는 HTTP 전역 Prefix를 검증하고 끝의 '/'를 제거합니다.
+func normalizeGlobalPrefix(prefix string) (string, error) {
+	if prefix == "" {
+		return "", nil
+	}
+	if !strings.HasPrefix(prefix, "/") {
+		return "", fmt.Errorf("HTTP global prefix must start with '/'")
+	}
+	if strings.Contains(prefix, ":") {
+		return "", fmt.Errorf("path parameters are not allowed in the HTTP global prefix")
+	}
+	if strings.Contains(prefix, "*") {
+		return "", fmt.Errorf("wildcards are not allowed in the HTTP global prefix")
+	}
+	return strings.TrimSuffix(prefix, "/"), nil
+}
+
+// GenerateOpenAPI는 라우트 선언으로부터 OpenAPI 3.1 문서(JSON)를 생성합니다.
+// 서버를 띄우지 않고 테스트나 명령에서 문서를 추출할 때도 사용합니다.
+func GenerateOpenAPI(routes []spineRouter.RouteSpec, opts boot.HTTPOptions) ([]byte, error) {
+	prefix, err := normalizeGlobalPrefix(opts.GlobalPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	info := openapi.Info{}
+	if opts.OpenAPI != nil {
+		info = openapi.Info{
+			Title:       opts.OpenAPI.Title,
+			Version:     opts.OpenAPI.Version,
+			Description: opts.OpenAPI.Description,
+		}
+	}
+
+	document, err := openapi.Generate(info, prefix, routes)
+	if err != nil {
+		return nil, err
+	}
+	return document.JSON()
+}
+
+// JoinPath는 prefix와 라우트 경로를 잇습니다. 경로에 leading slash가 없으면 보정합니다.
+// 라우트 그룹도 같은 규칙으로 전체 경로를 만듭니다.
+func JoinPath(prefix, path string) (string, error) {
 	if path == "" {
 		return "", fmt.Errorf("route path cannot be empty")
 	}
@@ -578,28 +892,44 @@ func joinPath(prefix, path string) (string, error) {
 	return prefix + path, nil
 }
 
+/*
+assertNoAmbiguousRoute는 같은 요청 경로에 매칭되는 두 라우트 중 우선순위로 결정할 수 없는 경우를 거부합니다.
+
+  - 같은 길이의 경로는 모든 위치에서 교집합이 있으면 충돌로 봅니다.
+    (/users/:id 와 /users/me 는 충돌, /users/:id<int> 와 /users/me, /users/:slug 는 충돌하지 않음)
+  - 서로 다른 제약 조건은 교집합이 없다고 알려진 내장 제약 조건이 아니면 충돌로 봅니다.
+    (/users/:id<int> 와 /users/:name<alpha> 는 공존 가능, /users/:id<int> 와 /users/:n<[0-9]+> 는 충돌)
+  - 와일드카드 라우트는 더 구체적인 라우트가 먼저 시도되므로 길이가 다른 라우트와 충돌하지 않습니다.
+    (/files/*path 와 /files/:id 는 공존 가능)
+  - 같은 위치에 와일드카드를 둔 라우트끼리는 앞부분이 겹치면 충돌입니다.
+*/
 func assertNoAmbiguousRoute(method, newPath string, existing []string) error {
-	newSegs := splitPathForValidation(newPath)
+	newSegs, err := spineRouter.ParsePattern(newPath)
+	if err != nil {
+		return fmt.Errorf("[Router] %w", err)
+	}
 
 	for _, oldPath := range existing {
-		oldSegs := splitPathForValidation(oldPath)
+		oldSegs, err := spineRouter.ParsePattern(oldPath)
+		if err != nil {
+			return fmt.Errorf("[Router] %w", err)
+		}
 
-		// 서로 다른 segment length는 절대 겹치지 않음
 		if len(newSegs) != len(oldSegs) {
 			continue
 		}
 
+		// 와일드카드는 마지막에만 올 수 있으므로 한쪽만 와일드카드면 항상 우선순위로 구분된다.
+		newWildcard := len(newSegs) > 0 && newSegs[len(newSegs)-1].Kind == spineRouter.WildcardSegment
+		oldWildcard := len(oldSegs) > 0 && oldSegs[len(oldSegs)-1].Kind == spineRouter.WildcardSegment
+		if newWildcard != oldWildcard {
+			continue
+		}
+
 		// 각 segment가 충돌 없이 겹치는지(교집합 존재) 검사
 		overlaps := true
 		for i := range newSegs {
-			a := newSegs[i]
-			b := oldSegs[i]
-
-			aParam := isPathParam(a)
-			bParam := isPathParam(b)
-
-			// 둘 다 literal인데 값이 다르면 이 위치에서 교집합이 사라짐
-			if !aParam && !bParam && a != b {
+			if !segmentsOverlap(newSegs[i], oldSegs[i]) {
 				overlaps = false
 				break
 			}
@@ -615,23 +945,29 @@ func assertNoAmbiguousRoute(method, newPath string, existing []string) error {
 	return nil
 }
 
-func splitPathForValidation(path string) []string {
-	p := strings.TrimSpace(path)
-	if p == "" || p == "/" {
-		return []string{}
-	}
+/*
+segmentsOverlap은 두 세그먼트에 동시에 매칭되는 값이 있고, 라우터의 우선순위로도 순서를 정할 수 없는지 판단합니다.
 
-	p = strings.TrimPrefix(p, "/")
-	p = strings.TrimSuffix(p, "/")
-	if p == "" {
-		return []string{}
+  - 제약 파라미터는 정적 세그먼트 다음, 일반 파라미터보다 먼저 시도되므로 둘과는 겹치지 않습니다.
+  - 서로 다른 제약 조건은 등록 순서로 시도되므로, 교집합이 없다고 알려진 내장 제약 조건끼리만 겹치지 않습니다.
+*/
+func segmentsOverlap(a, b spineRouter.Segment) bool {
+	if a.Kind > b.Kind {
+		a, b = b, a
 	}
 
-	return strings.Split(p, "/")
-}
-
-func isPathParam(seg string) bool {
-	return strings.HasPrefix(seg, ":")
+	switch {
+	case a.Kind == spineRouter.StaticSegment && b.Kind == spineRouter.ConstrainedSegment:
+		return false
+	case a.Kind == spineRouter.StaticSegment:
+		return b.Matches(a.Value)
+	case a.Kind == spineRouter.ConstrainedSegment && b.Kind == spineRouter.ConstrainedSegment:
+		return a.Constraint == b.Constraint || !spineRouter.ConstraintsDisjoint(a.Constraint, b.Constraint)
+	case a.Kind == spineRouter.ConstrainedSegment && b.Kind == spineRouter.ParamSegment:
+		return false
+	default:
+		return true
+	}
 }
 
 // forwardConsumerErrors는 특정 런타임의 치명적 에러를 공용 채널로 전달한다.
@@ -668,7 +1004,12 @@ func printBanner() {
 	log.Printf("[Bootstrap] Spine version: %s", "v0.4.3")
 }
 
-func buildConsumerPipeline(container *container.Container, registry *consumer.Registry, dispatchHook *hook.EventDispatchHook) *pipeline.Pipeline {
+func buildConsumerPipeline(
+	container *container.Container,
+	registry *consumer.Registry,
+	dispatchHook hook.PostExecutionHook,
+	codecs *codec.Registry,
+) *pipeline.Pipeline {
 	consumerRouter := spineRouter.NewRouter()
 	for _, registration := range registry.Registrations() {
 		consumerRouter.Register("EVENT", registration.Topic, registration.Meta)
@@ -682,38 +1023,84 @@ func buildConsumerPipeline(container *container.Container, registry *consumer.Re
 	}
 
 	consumerPipeline.AddArgumentResolver(
-		&resolver.StdContextResolver{},
+		&resolver.StdContextResolver{Codecs: codecs},
 		&eventResolver.EventNameResolver{},
 		&eventResolver.PayloadResolver{},
-		&eventResolver.DTOResolver{},
+		&eventResolver.MetadataResolver{},
+		&eventResolver.DTOResolver{Codecs: codecs},
 	)
 
+	// 요청 메시지를 처리한 핸들러의 반환값은 응답으로 보낸다.
+	consumerPipeline.AddReturnValueHandler(&eventHandler.ReplyReturnHandler{})
+
 	return consumerPipeline
 }
 
 func buildWSPipeline(
 	container *container.Container,
 	registry *ws.Registry,
-	dispatchHook *hook.EventDispatchHook,
-) *pipeline.Pipeline {
-	wsRouter := spineRouter.NewRouter()
+	dispatchHook hook.PostExecutionHook,
+	codecs *codec.Registry,
+) (*pipeline.Pipeline, error) {
+	interceptors := func(reg ws.Registration) ([]core.Interceptor, error) {
+		return resolveRegistrationInterceptors(container, reg.Options.Interceptors, "WebSocket", reg.Path)
+	}
+	return ws.NewPipeline(container, registry, interceptors, dispatchHook, codecs)
+}
+
+// resolveRegistrationInterceptors는 등록 옵션의 Interceptor를 준비합니다.
+// 라우트 Interceptor와 같이 nil 포인터면 컨테이너에서 생성한다.
+func resolveRegistrationInterceptors(container *container.Container, interceptors []core.Interceptor, kind string, name string) ([]core.Interceptor, error) {
+	resolved := make([]core.Interceptor, len(interceptors))
+	for i, interceptor := range interceptors {
+		interceptorType := reflect.TypeOf(interceptor)
+		if interceptorType == nil {
+			return nil, fmt.Errorf("[Bootstrap] %s interceptor[%d] is nil (%s)", kind, i, name)
+		}
+
+		if interceptorType.Kind() == reflect.Pointer && reflect.ValueOf(interceptor).IsNil() {
+			inst, err := container.Resolve(interceptorType)
+			if err != nil {
+				return nil, fmt.Errorf("[Bootstrap] failed to create %s interceptor: %w", kind, err)
+			}
+			resolved[i] = inst.(core.Interceptor)
+			continue
+		}
+		resolved[i] = interceptor
+	}
+	return resolved, nil
+}
+
+func buildSchedulePipeline(
+	container *container.Container,
+	registry *scheduler.Registry,
+	dispatchHook hook.PostExecutionHook,
+	codecs *codec.Registry,
+) (*pipeline.Pipeline, error) {
+	jobRouter := spineRouter.NewRouter()
 	for _, reg := range registry.Registrations() {
-		wsRouter.Register("WS", reg.Path, reg.Meta)
+		meta := reg.Meta
+
+		interceptors, err := resolveRegistrationInterceptors(container, reg.Options.Interceptors, "scheduled job", reg.Name)
+		if err != nil {
+			return nil, err
+		}
+		meta.Interceptors = interceptors
+
+		jobRouter.Register("SCHEDULE", reg.Name, meta)
 	}
 
-	wsInvoker := invoker.NewInvoker(container)
-	wsPipeline := pipeline.NewPipeline(wsRouter, wsInvoker)
+	jobInvoker := invoker.NewInvoker(container)
+	jobPipeline := pipeline.NewPipeline(jobRouter, jobInvoker)
 
 	if dispatchHook != nil {
-		wsPipeline.AddPostExecutionHook(dispatchHook)
+		jobPipeline.AddPostExecutionHook(dispatchHook)
 	}
 
-	wsPipeline.AddArgumentResolver(
-		&resolver.StdContextResolver{},
-		&wsResolver.ConnectionIDResolver{},
-		&wsResolver.PayloadResolver{},
-		&wsResolver.DTOResolver{},
+	jobPipeline.AddArgumentResolver(
+		&resolver.StdContextResolver{Codecs: codecs},
+		&resolver.ControllerContextResolver{},
 	)
 
-	return wsPipeline
+	return jobPipeline, nil
 }
@@ -2,8 +2,10 @@ package bootstrap
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -11,6 +13,7 @@ import (
 	"github.com/NARUBROWN/spine/core"
 	spineRouter "github.com/NARUBROWN/spine/internal/router"
 	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/event/outbox"
 )
 
 type testController struct{}
@@ -41,16 +44,16 @@ func (t *testTransport) Stop(ctx context.Context) error {
 }
 
 func TestJoinPath(t *testing.T) {
-	if got, err := joinPath("", "users"); err != nil || got != "/users" {
+	if got, err := JoinPath("", "users"); err != nil || got != "/users" {
 		t.Fatalf("leading slash가 보정되어야 합니다: %s", got)
 	}
-	if got, err := joinPath("/api", "/users"); err != nil || got != "/api/users" {
+	if got, err := JoinPath("/api", "/users"); err != nil || got != "/api/users" {
 		t.Fatalf("prefix 결합이 잘못되었습니다: %s", got)
 	}
 }
 
 func TestJoinPath_EmptyReturnsError(t *testing.T) {
-	if _, err := joinPath("", ""); err == nil {
+	if _, err := JoinPath("", ""); err == nil {
 		t.Fatal("빈 path는 에러여야 합니다")
 	}
 }
@@ -67,6 +70,41 @@ func TestAssertNoAmbiguousRoute(t *testing.T) {
 	}
 }
 
+func TestAssertNoAmbiguousRoute_WildcardAndConstrainedSegments(t *testing.T) {
+	allowed := []struct{ newPath, oldPath string }{
+		{"/files/*path", "/files/:id"},
+		{"/files/:id", "/files/*path"},
+		{"/files/*path", "/files/raw/*rest"},
+		{"/users/:id<int>", "/users/me"},
+		{"/posts/:id<int>", "/posts/:slug<alpha>"},
+		{"/posts/:id<uuid>", "/posts/:slug<alnum>"},
+		// 제약 파라미터는 정적 세그먼트 다음, 일반 파라미터보다 먼저 시도된다.
+		{"/users/:id<int>", "/users/:userId"},
+		{"/users/:id<[a-z]+>", "/users/me"},
+	}
+	for _, tc := range allowed {
+		if err := assertNoAmbiguousRoute("GET", tc.newPath, []string{tc.oldPath}); err != nil {
+			t.Fatalf("%s / %s: 예상하지 못한 에러입니다: %v", tc.newPath, tc.oldPath, err)
+		}
+	}
+
+	rejected := []struct{ newPath, oldPath string }{
+		{"/files/*path", "/files/*rest"},
+		{"/files/*path", "/:dir/*rest"},
+		{"/users/:id<int>", "/users/:userId<int>"},
+		// 서로 다른 제약 조건은 교집합을 알 수 없으면 등록 순서로 가려지므로 거부한다.
+		{"/posts/:id<int>", "/posts/:slug<[a-z-]+>"},
+		{"/a/:x<int>", "/a/:y<[0-9]+>"},
+		{"/a/:x<int>", "/a/:y<uint>"},
+		{"/files/*path/edit", "/other"},
+	}
+	for _, tc := range rejected {
+		if err := assertNoAmbiguousRoute("GET", tc.newPath, []string{tc.oldPath}); err == nil {
+			t.Fatalf("%s / %s: 충돌 또는 잘못된 라우트는 에러여야 합니다", tc.newPath, tc.oldPath)
+		}
+	}
+}
+
 func TestRun_InvalidGlobalPrefixReturnsError(t *testing.T) {
 	for _, prefix := range []string{"api", "/api/:id", "/api/*"} {
 		prefix := prefix
@@ -94,6 +132,27 @@ func TestRun_AmbiguousRoutesReturnsError(t *testing.T) {
 	}
 }
 
+func TestRun_OutboxSQLStoreRequiresScopedTx(t *testing.T) {
+	store, err := outbox.NewSQLStore(&sql.DB{}, outbox.SQLOptions{})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	for _, constructors := range [][]any{
+		{func() outbox.Store { return store }},
+		{func() outbox.Store { return store }, func() *outbox.Tx { return &outbox.Tx{} }},
+	} {
+		err := Run(Config{
+			Constructors: constructors,
+			InMemory:     &boot.InMemoryOptions{},
+			Outbox:       &boot.OutboxOptions{},
+		})
+		if err == nil || !strings.Contains(err.Error(), "outbox.Tx") {
+			t.Fatalf("SQLStore는 Scoped Tx 없이 기동하면 안 됩니다: %v", err)
+		}
+	}
+}
+
 func TestRun_CustomTransportInitError(t *testing.T) {
 	transport := &testTransport{initErr: errors.New("init fail")}
 
@@ -151,3 +210,136 @@ func TestRun_NilGlobalInterceptorReturnsError(t *testing.T) {
 		t.Fatalf("nil 인터셉터는 에러여야 합니다: %v", err)
 	}
 }
+
+type lifecycleEvents struct {
+	mu     sync.Mutex
+	events []string
+}
+
+func (e *lifecycleEvents) add(event string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.events = append(e.events, event)
+}
+
+type lifecycleDB struct {
+	events   *lifecycleEvents
+	startErr error
+}
+
+func (d *lifecycleDB) OnStart(ctx context.Context) error {
+	d.events.add("start:db")
+	return d.startErr
+}
+
+func (d *lifecycleDB) OnStop(ctx context.Context) error {
+	d.events.add("stop:db")
+	return nil
+}
+
+type lifecycleCache struct {
+	events *lifecycleEvents
+	db     *lifecycleDB
+}
+
+func (c *lifecycleCache) OnStart(ctx context.Context) error {
+	c.events.add("start:cache")
+	return nil
+}
+
+func (c *lifecycleCache) OnStop(ctx context.Context) error {
+	if _, ok := ctx.Deadline(); !ok {
+		c.events.add("stop:cache:no-deadline")
+		return nil
+	}
+	c.events.add("stop:cache")
+	return errors.New("cache stop fail")
+}
+
+func TestRun_LifecycleComponentsStartInDependencyOrderAndStopInReverse(t *testing.T) {
+	events := &lifecycleEvents{}
+	transport := &testTransport{startErr: errors.New("start fail")}
+
+	err := Run(Config{
+		Constructors: []any{
+			// 의존하는 쪽을 먼저 등록해도 의존 대상이 먼저 시작되어야 함
+			func(db *lifecycleDB) *lifecycleCache { return &lifecycleCache{events: events, db: db} },
+			func() *lifecycleDB { return &lifecycleDB{events: events} },
+		},
+		CustomTransports: []core.CustomTransport{transport},
+		ShutdownTimeout:  10 * time.Millisecond,
+	})
+	if err == nil || !strings.Contains(err.Error(), "start fail") {
+		t.Fatalf("Start 에러가 반환되어야 합니다: %v", err)
+	}
+
+	want := []string{"start:db", "start:cache", "stop:cache", "stop:db"}
+	if strings.Join(events.events, ",") != strings.Join(want, ",") {
+		t.Fatalf("라이프사이클 호출 순서가 잘못되었습니다. got=%v want=%v", events.events, want)
+	}
+}
+
+func TestRun_LifecycleStartFailureStopsStartedComponentsOnly(t *testing.T) {
+	events := &lifecycleEvents{}
+	transport := &testTransport{}
+
+	err := Run(Config{
+		Constructors: []any{
+			func() *lifecycleDB { return &lifecycleDB{events: events, startErr: errors.New("db down")} },
+			func(db *lifecycleDB) *lifecycleCache { return &lifecycleCache{events: events, db: db} },
+		},
+		CustomTransports: []core.CustomTransport{transport},
+		ShutdownTimeout:  10 * time.Millisecond,
+	})
+	if err == nil || !strings.Contains(err.Error(), "db down") {
+		t.Fatalf("OnStart 에러가 반환되어야 합니다: %v", err)
+	}
+	if transport.initCalls.Load() != 0 {
+		t.Fatalf("컴포넌트 시작 실패 시 트랜스포트는 초기화되면 안 됩니다: %d", transport.initCalls.Load())
+	}
+	if len(events.events) != 1 || events.events[0] != "start:db" {
+		t.Fatalf("시작되지 않은 컴포넌트는 정리되면 안 됩니다: %v", events.events)
+	}
+}
+
+type lifecyclePinger interface {
+	Ping() string
+}
+
+type lifecyclePool struct {
+	events *lifecycleEvents
+}
+
+func (p *lifecyclePool) Ping() string { return "pong" }
+
+func (p *lifecyclePool) OnStart(ctx context.Context) error {
+	p.events.add("start:pool")
+	return nil
+}
+
+func (p *lifecyclePool) OnStop(ctx context.Context) error {
+	p.events.add("stop:pool")
+	return nil
+}
+
+func TestRun_LifecycleStartsInterfaceReturningConstructors(t *testing.T) {
+	events := &lifecycleEvents{}
+	transport := &testTransport{startErr: errors.New("start fail")}
+
+	err := Run(Config{
+		Constructors: []any{
+			// 선언 타입은 인터페이스지만 구현체가 Starter / Stopper
+			func() lifecyclePinger { return &lifecyclePool{events: events} },
+		},
+		CustomTransports: []core.CustomTransport{transport},
+		ShutdownTimeout:  10 * time.Millisecond,
+	})
+	if err == nil || !strings.Contains(err.Error(), "start fail") {
+		t.Fatalf("Start 에러가 반환되어야 합니다: %v", err)
+	}
+
+	want := []string{"start:pool", "stop:pool"}
+	if strings.Join(events.events, ",") != strings.Join(want, ",") {
+		t.Fatalf("라이프사이클 호출 순서가 잘못되었습니다. got=%v want=%v", events.events, want)
+	}
+}
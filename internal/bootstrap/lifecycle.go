@@ -0,0 +1,83 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sync"
+
+	"github.com/NARUBROWN/spine/internal/container"
+	"github.com/NARUBROWN/spine/pkg/di"
+)
+
+var (
+	starterType = reflect.TypeFor[di.Starter]()
+	stopperType = reflect.TypeFor[di.Stopper]()
+)
+
+// componentLifecycle은 컨테이너가 만든 Singleton의 OnStart / OnStop 호출을 관리합니다.
+type componentLifecycle struct {
+	container *container.Container
+	// started는 OnStart가 성공한 인스턴스의 Singletons() 인덱스입니다.
+	started  map[int]struct{}
+	stopOnce sync.Once
+}
+
+func newComponentLifecycle(container *container.Container) *componentLifecycle {
+	return &componentLifecycle{
+		container: container,
+		started:   make(map[int]struct{}),
+	}
+}
+
+/*
+start는 Starter / Stopper일 수 있는 Singleton을 모두 생성한 뒤, 의존성 순서대로 OnStart를 호출합니다.
+  - 선언 타입이 인터페이스인 생성자는 구현체가 Starter일 수 있으므로 함께 생성합니다.
+  - Starter 여부는 선언 타입이 아니라 생성된 인스턴스로 판단합니다.
+  - 이후 트랜스포트 Warm-up이 만드는 Singleton은 Starter가 아니므로 OnStart 대상이 아닙니다.
+*/
+func (l *componentLifecycle) start(ctx context.Context) error {
+	err := l.container.WarmUpWhere(func(t reflect.Type) bool {
+		return t.Kind() == reflect.Interface || t.Implements(starterType) || t.Implements(stopperType)
+	})
+	if err != nil {
+		return fmt.Errorf("[Bootstrap] lifecycle component warm-up failed: %w", err)
+	}
+
+	for i, instance := range l.container.Singletons() {
+		starter, ok := instance.(di.Starter)
+		if !ok {
+			continue
+		}
+		log.Printf("[Bootstrap] Starting component: %T", instance)
+		if err := starter.OnStart(ctx); err != nil {
+			return fmt.Errorf("[Bootstrap] failed to start component %T: %w", instance, err)
+		}
+		l.started[i] = struct{}{}
+	}
+	return nil
+}
+
+// stop은 생성 역순으로 OnStop을 호출합니다.
+// Starter는 OnStart가 성공한 인스턴스만 정리합니다.
+func (l *componentLifecycle) stop(ctx context.Context) {
+	l.stopOnce.Do(func() {
+		instances := l.container.Singletons()
+		for i := len(instances) - 1; i >= 0; i-- {
+			instance := instances[i]
+			stopper, ok := instance.(di.Stopper)
+			if !ok {
+				continue
+			}
+			if _, isStarter := instance.(di.Starter); isStarter {
+				if _, started := l.started[i]; !started {
+					continue
+				}
+			}
+			if err := stopper.OnStop(ctx); err != nil {
+				log.Printf("[Bootstrap] failed to stop component %T: %v", instance, err)
+			}
+		}
+	})
+}
@@ -6,13 +6,49 @@ import (
 	"reflect"
 	"strings"
 	"sync"
+
+	"github.com/NARUBROWN/spine/pkg/di"
 )
 
 type Container struct {
-	mu           sync.RWMutex
-	constructors map[reflect.Type]reflect.Value
-	instances    map[reflect.Type]any
-	building     map[reflect.Type]*buildState
+	mu            sync.RWMutex
+	registrations []*registration
+	// index는 등록 키(타입 + 이름)별 registrations 위치입니다.
+	index map[dependency]int
+	// selected와 validated는 생성자 선택과 의존성 그래프 검증 결과의 캐시이며, 등록이 바뀌면 비워집니다.
+	selected  map[dependency]*registration
+	validated map[validationKey]struct{}
+	instances map[dependency]any
+	// created는 Singleton 인스턴스를 생성 완료 순서대로 보관합니다. (의존 대상이 먼저 위치)
+	created  []any
+	building map[dependency]*buildState
+}
+
+// dependency는 타입과 한정자 이름으로 구성된 의존성 식별자입니다.
+type dependency struct {
+	typ  reflect.Type
+	name string
+}
+
+func (d dependency) String() string {
+	if d.name == "" {
+		return d.typ.String()
+	}
+	return fmt.Sprintf("%s(%q)", d.typ, d.name)
+}
+
+// registration은 하나의 생성자 등록 정보입니다.
+// constructor가 비어 있으면 스코프가 직접 값을 제공하는 타입입니다.
+type registration struct {
+	outType     reflect.Type
+	name        string
+	primary     bool
+	constructor reflect.Value
+	lifetime    di.Lifetime
+}
+
+func (r *registration) key() dependency {
+	return dependency{typ: r.outType, name: r.name}
 }
 
 type buildState struct {
@@ -21,15 +57,40 @@ type buildState struct {
 	err      error
 }
 
+type validationKey struct {
+	dep      dependency
+	inScope  bool
+	captured bool
+}
+
+var qualifiedType = reflect.TypeFor[di.Qualified]()
+
 func New() *Container {
 	return &Container{
-		constructors: make(map[reflect.Type]reflect.Value),
-		instances:    make(map[reflect.Type]any),
-		building:     make(map[reflect.Type]*buildState),
+		index:     make(map[dependency]int),
+		selected:  make(map[dependency]*registration),
+		validated: make(map[validationKey]struct{}),
+		instances: make(map[dependency]any),
+		building:  make(map[dependency]*buildState),
 	}
 }
 
+// RegisterConstructor는 생성자 함수 또는 di.Provider 선언을 등록합니다.
 func (c *Container) RegisterConstructor(function any) error {
+	lifetime := di.LifetimeSingleton
+	name := ""
+	primary := false
+	if provider, ok := function.(di.Provider); ok {
+		function = provider.Constructor
+		lifetime = provider.Lifetime
+		name = provider.Name
+		primary = provider.Primary
+	}
+
+	if function == nil {
+		return errors.New("constructor must be a function")
+	}
+
 	val := reflect.ValueOf(function)
 	typ := val.Type()
 
@@ -41,78 +102,221 @@ func (c *Container) RegisterConstructor(function any) error {
 		return errors.New("constructor must return exactly one value")
 	}
 
-	outType := typ.Out(0)
+	switch lifetime {
+	case di.LifetimeSingleton, di.LifetimeScoped, di.LifetimeTransient:
+	default:
+		return fmt.Errorf("unknown constructor lifetime: %d", lifetime)
+	}
 
+	c.register(&registration{
+		outType:     typ.Out(0),
+		name:        name,
+		primary:     primary,
+		constructor: val,
+		lifetime:    lifetime,
+	})
+
+	return nil
+}
+
+// RegisterScopedValue는 생성자 없이 스코프가 직접 제공하는 타입을 선언합니다.
+// (예: 실행 중인 요청의 ControllerContext)
+func (c *Container) RegisterScopedValue(valueType reflect.Type) {
+	c.register(&registration{
+		outType:  valueType,
+		lifetime: di.LifetimeScoped,
+	})
+}
+
+// register는 같은 타입 + 이름의 기존 등록을 교체하고, 없으면 등록 순서대로 추가합니다.
+func (c *Container) register(reg *registration) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
-	c.constructors[outType] = val
 
-	return nil
+	clear(c.selected)
+	clear(c.validated)
+
+	if i, ok := c.index[reg.key()]; ok {
+		c.registrations[i] = reg
+		return
+	}
+	c.index[reg.key()] = len(c.registrations)
+	c.registrations = append(c.registrations, reg)
+}
+
+// Lifetime은 지정한 타입을 생성할 생성자의 수명을 반환합니다.
+func (c *Container) Lifetime(componentType reflect.Type) (di.Lifetime, error) {
+	reg, err := c.getConstructor(dependencyOf(componentType))
+	if err != nil {
+		return di.LifetimeSingleton, err
+	}
+	return reg.lifetime, nil
 }
 
 func (c *Container) Resolve(componentType reflect.Type) (any, error) {
-	if instance, ok := c.getInstance(componentType); ok {
-		return instance, nil
+	return c.resolveIn(nil, componentType)
+}
+
+func (c *Container) resolveIn(scope *Scope, componentType reflect.Type) (any, error) {
+	dep := dependencyOf(componentType)
+	if err := c.validate(dep, scope != nil); err != nil {
+		return nil, err
 	}
-	if err := c.validateDependencyGraph(componentType, map[reflect.Type]int{}, nil, map[reflect.Type]struct{}{}); err != nil {
+	instance, err := c.resolve(scope, dep, map[dependency]int{}, nil)
+	if err != nil {
 		return nil, err
 	}
-	return c.resolve(componentType, map[reflect.Type]int{}, nil)
+	return wrapQualified(componentType, instance).Interface(), nil
 }
 
-func (c *Container) validateDependencyGraph(
-	componentType reflect.Type,
-	stack map[reflect.Type]int,
-	path []reflect.Type,
-	validated map[reflect.Type]struct{},
-) error {
-	if _, ok := c.getInstance(componentType); ok {
+// dependencyOf는 파라미터 타입을 의존성 식별자로 변환합니다.
+// di.Named[T, Q] 한정자 타입은 대상 타입과 이름으로 풀어냅니다.
+func dependencyOf(paramType reflect.Type) dependency {
+	if isNamed(paramType) {
+		target, name := reflect.Zero(paramType).Interface().(di.Qualified).Target()
+		return dependency{typ: target, name: name}
+	}
+	return dependency{typ: paramType}
+}
+
+// isNamed는 파라미터 타입이 di.Named 인스턴스인지 확인합니다.
+// Target 메서드만 가진 다른 패키지의 구조체는 한정자로 취급하지 않습니다.
+func isNamed(paramType reflect.Type) bool {
+	return paramType.Kind() == reflect.Struct &&
+		paramType.PkgPath() == qualifiedType.PkgPath() &&
+		paramType.Implements(qualifiedType)
+}
+
+// wrapQualified는 한정자 타입 파라미터라면 해석된 인스턴스를 Value 필드에 담아 반환합니다.
+func wrapQualified(paramType reflect.Type, instance any) reflect.Value {
+	value := reflect.ValueOf(instance)
+	if !isNamed(paramType) {
+		return value
+	}
+
+	wrapped := reflect.New(paramType).Elem()
+	field := wrapped.Field(0)
+	if value.IsValid() {
+		field.Set(value)
+	}
+	return wrapped
+}
+
+// validate는 의존성 그래프를 검사하고, 통과한 결과를 등록이 바뀔 때까지 캐시합니다.
+// Resolve는 요청마다 호출되므로 같은 의존성에 대해 그래프 전체를 다시 순회하지 않습니다.
+func (c *Container) validate(dep dependency, inScope bool) error {
+	key := validationKey{dep: dep, inScope: inScope}
+	c.mu.RLock()
+	_, ok := c.validated[key]
+	c.mu.RUnlock()
+	if ok {
 		return nil
 	}
-	if _, ok := validated[componentType]; ok {
+
+	validated := map[validationKey]struct{}{}
+	if err := c.validateDependencyGraph(dep, inScope, nil, map[dependency]int{}, nil, validated); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.validated[key] = struct{}{}
+	for k := range validated {
+		c.validated[k] = struct{}{}
+	}
+	return nil
+}
+
+// validateDependencyGraph는 생성 전에 순환 의존성과 수명 규칙을 검사합니다.
+// owner는 가장 가까운 Singleton 상위 컴포넌트이며, 그 아래에서 Scoped 컴포넌트를 만나면 거부합니다.
+func (c *Container) validateDependencyGraph(
+	dep dependency,
+	inScope bool,
+	owner *dependency,
+	stack map[dependency]int,
+	path []dependency,
+	validated map[validationKey]struct{},
+) error {
+	key := validationKey{dep: dep, inScope: inScope, captured: owner != nil}
+	if _, ok := validated[key]; ok {
 		return nil
 	}
-	if idx, ok := stack[componentType]; ok {
-		cycle := append([]reflect.Type{}, path[idx:]...)
-		cycle = append(cycle, componentType)
-		return fmt.Errorf("circular dependency detected: %s", formatTypePath(cycle))
+	if idx, ok := stack[dep]; ok {
+		cycle := append([]dependency{}, path[idx:]...)
+		cycle = append(cycle, dep)
+		return fmt.Errorf("circular dependency detected: %s", formatPath(cycle))
 	}
 
-	constructor, err := c.getConstructor(componentType)
+	reg, err := c.getConstructor(dep)
 	if err != nil {
 		return err
 	}
 
-	stack[componentType] = len(path)
-	path = append(path, componentType)
-	defer delete(stack, componentType)
+	switch reg.lifetime {
+	case di.LifetimeSingleton:
+		if _, ok := c.getInstance(reg.key()); ok {
+			return nil
+		}
+		regKey := reg.key()
+		owner = &regKey
+	case di.LifetimeScoped:
+		if owner != nil {
+			return fmt.Errorf("singleton %v cannot depend on scoped component %v", *owner, reg.key())
+		}
+		if !inScope {
+			return fmt.Errorf("scoped component %v cannot be resolved outside of an execution scope", reg.key())
+		}
+	}
 
-	for i := 0; i < constructor.Type().NumIn(); i++ {
-		if err := c.validateDependencyGraph(constructor.Type().In(i), stack, path, validated); err != nil {
-			return err
+	stack[dep] = len(path)
+	path = append(path, dep)
+	defer delete(stack, dep)
+
+	if reg.constructor.IsValid() {
+		for i := 0; i < reg.constructor.Type().NumIn(); i++ {
+			if err := c.validateDependencyGraph(dependencyOf(reg.constructor.Type().In(i)), inScope, owner, stack, path, validated); err != nil {
+				return err
+			}
 		}
 	}
-	validated[componentType] = struct{}{}
+	validated[key] = struct{}{}
 	return nil
 }
 
-func (c *Container) resolve(componentType reflect.Type, stack map[reflect.Type]int, path []reflect.Type) (any, error) {
-	if idx, ok := stack[componentType]; ok {
-		cycle := append([]reflect.Type{}, path[idx:]...)
-		cycle = append(cycle, componentType)
-		return nil, fmt.Errorf("circular dependency detected: %s", formatTypePath(cycle))
-	}
-
-	if instance, ok := c.getInstance(componentType); ok {
-		return instance, nil
+func (c *Container) resolve(scope *Scope, dep dependency, stack map[dependency]int, path []dependency) (any, error) {
+	if idx, ok := stack[dep]; ok {
+		cycle := append([]dependency{}, path[idx:]...)
+		cycle = append(cycle, dep)
+		return nil, fmt.Errorf("circular dependency detected: %s", formatPath(cycle))
 	}
 
-	constructor, err := c.getConstructor(componentType)
+	reg, err := c.getConstructor(dep)
 	if err != nil {
 		return nil, err
 	}
 
-	state, wait, ok := c.beginBuild(componentType)
+	switch reg.lifetime {
+	case di.LifetimeScoped:
+		if scope == nil {
+			return nil, fmt.Errorf("scoped component %v cannot be resolved outside of an execution scope", reg.key())
+		}
+		return scope.resolve(reg, dep, stack, path)
+	case di.LifetimeTransient:
+		result, err := c.construct(scope, reg, dep, stack, path)
+		if err != nil {
+			return nil, err
+		}
+		if scope != nil {
+			scope.track(result)
+		}
+		return result, nil
+	}
+
+	if instance, ok := c.getInstance(reg.key()); ok {
+		return instance, nil
+	}
+
+	state, wait, ok := c.beginBuild(reg.key())
 	if !ok {
 		return state.instance, state.err
 	}
@@ -122,30 +326,15 @@ func (c *Container) resolve(componentType reflect.Type, stack map[reflect.Type]i
 			return state.instance, state.err
 		}
 	}
-	defer c.finishBuild(componentType, state)
+	defer c.finishBuild(reg.key(), state)
 
-	stack[componentType] = len(path)
-	path = append(path, componentType)
-	defer delete(stack, componentType)
-
-	numIn := constructor.Type().NumIn()
-	args := make([]reflect.Value, numIn)
-	for i := 0; i < numIn; i++ {
-		paramType := constructor.Type().In(i)
-		paramInstance, err := c.resolve(paramType, stack, path)
-		if err != nil {
-			state.err = err
-			return nil, err
-		}
-		args[i] = reflect.ValueOf(paramInstance)
-	}
-
-	result, err := callConstructor(constructor, args)
+	// Singleton의 의존성은 특정 스코프에 묶이지 않도록 스코프 없이 생성합니다.
+	result, err := c.construct(nil, reg, dep, stack, path)
 	if err != nil {
 		state.err = err
 		return nil, err
 	}
-	if cached, existed := c.cacheInstance(componentType, result); existed {
+	if cached, existed := c.cacheInstance(reg.key(), result); existed {
 		state.instance = cached
 		return cached, nil
 	}
@@ -154,6 +343,31 @@ func (c *Container) resolve(componentType reflect.Type, stack map[reflect.Type]i
 	return result, nil
 }
 
+// construct는 의존성을 해석한 뒤 생성자를 호출합니다.
+func (c *Container) construct(scope *Scope, reg *registration, dep dependency, stack map[dependency]int, path []dependency) (any, error) {
+	if !reg.constructor.IsValid() {
+		return nil, fmt.Errorf("scoped value %v was not provided by the execution scope", reg.outType)
+	}
+
+	stack[dep] = len(path)
+	path = append(path, dep)
+	defer delete(stack, dep)
+
+	constructor := reg.constructor
+	numIn := constructor.Type().NumIn()
+	args := make([]reflect.Value, numIn)
+	for i := 0; i < numIn; i++ {
+		paramType := constructor.Type().In(i)
+		paramInstance, err := c.resolve(scope, dependencyOf(paramType), stack, path)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = wrapQualified(paramType, paramInstance)
+	}
+
+	return callConstructor(constructor, args)
+}
+
 func callConstructor(constructor reflect.Value, args []reflect.Value) (result any, err error) {
 	defer func() {
 		if recovered := recover(); recovered != nil {
@@ -168,91 +382,200 @@ func callConstructor(constructor reflect.Value, args []reflect.Value) (result an
 	return constructor.Call(args)[0].Interface(), nil
 }
 
-func (c *Container) getInstance(componentType reflect.Type) (any, bool) {
+func (c *Container) getInstance(key dependency) (any, bool) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
-	instance, ok := c.instances[componentType]
+	instance, ok := c.instances[key]
 	return instance, ok
 }
 
-func (c *Container) getConstructor(componentType reflect.Type) (reflect.Value, error) {
+/*
+getConstructor는 의존성에 맞는 생성자를 선택합니다.
+  - 이름이 지정되면 같은 이름의 등록만 후보가 됩니다.
+  - 정확한 타입 일치를 우선하고, 인터페이스라면 할당 가능한 구현체를 탐색합니다.
+  - 스코프가 제공하는 값은 정확한 타입 일치로만 선택됩니다.
+  - 후보가 여러 개면 Primary 등록, 그다음 이름 없는 기본 등록을 선택합니다.
+
+선택 결과는 등록이 바뀔 때까지 캐시합니다.
+*/
+func (c *Container) getConstructor(dep dependency) (*registration, error) {
+	c.mu.RLock()
+	reg, ok := c.selected[dep]
+	c.mu.RUnlock()
+	if ok {
+		return reg, nil
+	}
+
+	reg, err := c.selectConstructor(dep)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// 선택하는 동안 등록이 바뀌었다면 캐시하지 않는다.
+	if i, ok := c.index[reg.key()]; ok && c.registrations[i] == reg {
+		c.selected[dep] = reg
+	}
+	return reg, nil
+}
+
+func (c *Container) selectConstructor(dep dependency) (*registration, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	// 정확한 타입 일치하는 생성자 우선 탐색
-	if v, ok := c.constructors[componentType]; ok {
-		return v, nil
+	if dep.name != "" {
+		// 이름이 지정되면 같은 타입 + 이름의 등록이 곧 정확한 일치다.
+		if i, ok := c.index[dep]; ok {
+			return c.registrations[i], nil
+		}
 	}
 
-	// 인터페이스 타입인 경우, 할당 가능한 생성자 탐색
-	if componentType.Kind() == reflect.Interface {
-		var matched reflect.Value
-		matches := 0
-		for outType, v := range c.constructors {
-			if outType.AssignableTo(componentType) {
-				matched = v
-				matches++
-			}
+	var exact, assignable []*registration
+	for _, reg := range c.registrations {
+		if dep.name != "" && reg.name != dep.name {
+			continue
+		}
+		if reg.outType == dep.typ {
+			exact = append(exact, reg)
+			continue
+		}
+		// 스코프가 제공하는 값(context.Context 등)은 선언한 타입으로만 주입한다.
+		if !reg.constructor.IsValid() {
+			continue
 		}
-		if matches == 1 {
-			return matched, nil
+		// 인터페이스 타입인 경우, 할당 가능한 생성자 탐색
+		if dep.typ.Kind() == reflect.Interface && reg.outType.AssignableTo(dep.typ) {
+			assignable = append(assignable, reg)
 		}
-		if matches > 1 {
-			return reflect.Value{}, fmt.Errorf("multiple constructors registered for interface %v", componentType)
+	}
+
+	candidates := exact
+	if len(candidates) == 0 {
+		candidates = assignable
+	}
+
+	switch len(candidates) {
+	case 0:
+		if dep.name != "" {
+			return nil, fmt.Errorf("no constructor registered for %v named %q", dep.typ, dep.name)
+		}
+		return nil, fmt.Errorf("no constructor registered for %v", dep.typ)
+	case 1:
+		return candidates[0], nil
+	}
+
+	if selected := selectSingle(candidates, func(reg *registration) bool { return reg.primary }); selected != nil {
+		return selected, nil
+	}
+	if dep.name == "" {
+		if selected := selectSingle(candidates, func(reg *registration) bool { return reg.name == "" }); selected != nil {
+			return selected, nil
 		}
 	}
 
-	return reflect.Value{}, fmt.Errorf("no constructor registered for %v", componentType)
+	if dep.typ.Kind() == reflect.Interface {
+		return nil, fmt.Errorf("multiple constructors registered for interface %v", dep)
+	}
+	return nil, fmt.Errorf("multiple constructors registered for %v", dep)
+}
+
+func selectSingle(candidates []*registration, match func(*registration) bool) *registration {
+	var selected *registration
+	for _, reg := range candidates {
+		if !match(reg) {
+			continue
+		}
+		if selected != nil {
+			return nil
+		}
+		selected = reg
+	}
+	return selected
 }
 
-func (c *Container) cacheInstance(componentType reflect.Type, instance any) (any, bool) {
+func (c *Container) cacheInstance(key dependency, instance any) (any, bool) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if existing, ok := c.instances[componentType]; ok {
+	if existing, ok := c.instances[key]; ok {
 		return existing, true
 	}
-	c.instances[componentType] = instance
+	c.instances[key] = instance
+	c.created = append(c.created, instance)
 	return instance, false
 }
 
-func (c *Container) beginBuild(componentType reflect.Type) (*buildState, bool, bool) {
+func (c *Container) beginBuild(key dependency) (*buildState, bool, bool) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if existing, ok := c.instances[componentType]; ok {
+	if existing, ok := c.instances[key]; ok {
 		return &buildState{instance: existing}, false, false
 	}
 
-	if state, ok := c.building[componentType]; ok {
+	if state, ok := c.building[key]; ok {
 		return state, true, true
 	}
 
 	state := &buildState{done: make(chan struct{})}
-	c.building[componentType] = state
+	c.building[key] = state
 	return state, false, true
 }
 
-func (c *Container) finishBuild(componentType reflect.Type, state *buildState) {
+func (c *Container) finishBuild(key dependency, state *buildState) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	if state.done != nil {
 		close(state.done)
 	}
-	delete(c.building, componentType)
+	delete(c.building, key)
 }
 
-func formatTypePath(path []reflect.Type) string {
+func formatPath(path []dependency) string {
 	parts := make([]string, len(path))
-	for i, t := range path {
-		parts[i] = t.String()
+	for i, dep := range path {
+		parts[i] = dep.String()
 	}
 	return strings.Join(parts, " -> ")
 }
 
+// Singletons는 지금까지 생성된 Singleton 인스턴스를 생성 순서대로 반환합니다.
+// 생성자는 의존성이 모두 만들어진 뒤 호출되므로, 이 순서는 곧 의존성 순서입니다.
+func (c *Container) Singletons() []any {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return append([]any(nil), c.created...)
+}
+
+// WarmUpWhere는 결과 타입이 조건을 만족하는 모든 Singleton 생성자를 미리 실행합니다.
+// 이름 있는 등록도 각각 생성되며, 등록 순서대로 처리합니다.
+func (c *Container) WarmUpWhere(match func(reflect.Type) bool) error {
+	c.mu.RLock()
+	var targets []*registration
+	for _, reg := range c.registrations {
+		if reg.lifetime == di.LifetimeSingleton && reg.constructor.IsValid() && match(reg.outType) {
+			targets = append(targets, reg)
+		}
+	}
+	c.mu.RUnlock()
+
+	for _, reg := range targets {
+		dep := reg.key()
+		if err := c.validate(dep, false); err != nil {
+			return err
+		}
+		if _, err := c.resolve(nil, dep, map[dependency]int{}, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // WarmUp은 지정한 타입 목록에 대해 미리 Resolve를 호출하여 인스턴스를 생성해 둡니다.
 // 이를 통해 런타임 중 초기화 비용을 분산시킬 수 있습니다.
+// Scoped / Transient 타입은 생성하지 않고 의존성 그래프만 검증합니다.
 func (c *Container) WarmUp(types []reflect.Type) error {
 	seen := make(map[reflect.Type]struct{})
 
@@ -262,6 +585,17 @@ func (c *Container) WarmUp(types []reflect.Type) error {
 		}
 		seen[t] = struct{}{}
 
+		lifetime, err := c.Lifetime(t)
+		if err != nil {
+			return err
+		}
+		if lifetime != di.LifetimeSingleton {
+			if err := c.validate(dependencyOf(t), true); err != nil {
+				return err
+			}
+			continue
+		}
+
 		// 후보 컴포넌트들을 순차적으로 인스턴스화
 		if _, err := c.Resolve(t); err != nil {
 			return err
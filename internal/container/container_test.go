@@ -1,6 +1,7 @@
 package container
 
 import (
+	"context"
 	"errors"
 	"reflect"
 	"strings"
@@ -8,6 +9,8 @@ import (
 	"sync/atomic"
 	"testing"
 	"time"
+
+	"github.com/NARUBROWN/spine/pkg/di"
 )
 
 type testRepo struct{}
@@ -325,3 +328,358 @@ func TestResolve_ConcurrentCycleReturnsErrorWithoutDeadlock(t *testing.T) {
 		}
 	}
 }
+
+type scopedUnit struct {
+	disposedWith error
+	disposed     int
+}
+
+func (u *scopedUnit) Dispose(err error) error {
+	u.disposed++
+	u.disposedWith = err
+	return nil
+}
+
+type scopedConsumer struct {
+	unit *scopedUnit
+}
+
+func TestResolve_TransientCreatesNewInstanceEveryTime(t *testing.T) {
+	c := New()
+	calls := 0
+	_ = c.RegisterConstructor(di.Transient(func() *testService {
+		calls++
+		return &testService{}
+	}))
+
+	first, err := c.Resolve(reflect.TypeOf(&testService{}))
+	if err != nil {
+		t.Fatalf("Resolve에 실패했습니다: %v", err)
+	}
+	second, err := c.Resolve(reflect.TypeOf(&testService{}))
+	if err != nil {
+		t.Fatalf("두 번째 Resolve에 실패했습니다: %v", err)
+	}
+	if first == second || calls != 2 {
+		t.Fatalf("Transient는 Resolve마다 새 인스턴스여야 합니다. calls=%d", calls)
+	}
+}
+
+func TestScope_SharesScopedInstanceAndDisposesOnClose(t *testing.T) {
+	c := New()
+	_ = c.RegisterConstructor(di.Scoped(func() *scopedUnit { return &scopedUnit{} }))
+	_ = c.RegisterConstructor(di.Scoped(func(u *scopedUnit) *scopedConsumer { return &scopedConsumer{unit: u} }))
+
+	scope := c.NewScope()
+	consumerAny, err := scope.Resolve(reflect.TypeOf(&scopedConsumer{}))
+	if err != nil {
+		t.Fatalf("스코프 Resolve에 실패했습니다: %v", err)
+	}
+	unitAny, err := scope.Resolve(reflect.TypeOf(&scopedUnit{}))
+	if err != nil {
+		t.Fatalf("스코프 Resolve에 실패했습니다: %v", err)
+	}
+	unit := unitAny.(*scopedUnit)
+	if consumerAny.(*scopedConsumer).unit != unit {
+		t.Fatal("같은 스코프에서는 동일한 Scoped 인스턴스를 공유해야 합니다")
+	}
+
+	other, err := c.NewScope().Resolve(reflect.TypeOf(&scopedUnit{}))
+	if err != nil {
+		t.Fatalf("다른 스코프 Resolve에 실패했습니다: %v", err)
+	}
+	if other == unitAny {
+		t.Fatal("스코프가 다르면 새 인스턴스여야 합니다")
+	}
+
+	execErr := errors.New("handler failed")
+	if err := scope.Close(execErr); err != nil {
+		t.Fatalf("스코프 종료에 실패했습니다: %v", err)
+	}
+	if unit.disposed != 1 || !errors.Is(unit.disposedWith, execErr) {
+		t.Fatalf("스코프 종료 시 실행 에러와 함께 Dispose되어야 합니다: disposed=%d err=%v", unit.disposed, unit.disposedWith)
+	}
+	if _, err := scope.Resolve(reflect.TypeOf(&scopedUnit{})); err == nil {
+		t.Fatal("닫힌 스코프에서는 Resolve가 실패해야 합니다")
+	}
+}
+
+func TestResolve_ScopedOutsideScopeReturnsError(t *testing.T) {
+	c := New()
+	_ = c.RegisterConstructor(di.Scoped(func() *scopedUnit { return &scopedUnit{} }))
+
+	_, err := c.Resolve(reflect.TypeOf(&scopedUnit{}))
+	if err == nil || !strings.Contains(err.Error(), "outside of an execution scope") {
+		t.Fatalf("스코프 밖 Scoped Resolve는 에러여야 합니다: %v", err)
+	}
+}
+
+func TestWarmUp_RejectsSingletonDependingOnScoped(t *testing.T) {
+	c := New()
+	_ = c.RegisterConstructor(di.Scoped(func() *scopedUnit { return &scopedUnit{} }))
+	_ = c.RegisterConstructor(di.Transient(func(u *scopedUnit) *testRepo { return &testRepo{} }))
+	_ = c.RegisterConstructor(func(r *testRepo) *testService { return &testService{repo: r} })
+
+	err := c.WarmUp([]reflect.Type{reflect.TypeOf(&testService{})})
+	if err == nil || !strings.Contains(err.Error(), "cannot depend on scoped component") {
+		t.Fatalf("Singleton이 Scoped에 의존하면 에러여야 합니다: %v", err)
+	}
+}
+
+func TestWarmUp_ValidatesScopedWithoutInstantiating(t *testing.T) {
+	c := New()
+	calls := 0
+	_ = c.RegisterConstructor(func() *testRepo { return &testRepo{} })
+	_ = c.RegisterConstructor(di.Scoped(func(r *testRepo) *scopedConsumer {
+		calls++
+		return &scopedConsumer{}
+	}))
+
+	if err := c.WarmUp([]reflect.Type{reflect.TypeOf(&scopedConsumer{})}); err != nil {
+		t.Fatalf("WarmUp에 실패했습니다: %v", err)
+	}
+	if calls != 0 {
+		t.Fatalf("Scoped 컴포넌트는 WarmUp에서 생성되면 안 됩니다: %d", calls)
+	}
+}
+
+func TestScope_ProvidesScopedValues(t *testing.T) {
+	c := New()
+	c.RegisterScopedValue(reflect.TypeFor[testIface]())
+	_ = c.RegisterConstructor(di.Scoped(func(i testIface) *scopedConsumer {
+		return &scopedConsumer{unit: &scopedUnit{}}
+	}))
+
+	scope := c.NewScope()
+	if _, err := scope.Resolve(reflect.TypeOf(&scopedConsumer{})); err == nil {
+		t.Fatal("제공되지 않은 스코프 값은 에러여야 합니다")
+	}
+
+	scope = c.NewScope()
+	scope.Provide(reflect.TypeFor[testIface](), &testImpl{})
+	if _, err := scope.Resolve(reflect.TypeOf(&scopedConsumer{})); err != nil {
+		t.Fatalf("스코프 값을 주입받아야 합니다: %v", err)
+	}
+}
+
+type doneNotifier interface {
+	Done() <-chan struct{}
+}
+
+func TestScope_ProvidedValuesMatchExactType(t *testing.T) {
+	c := New()
+	c.RegisterScopedValue(reflect.TypeFor[context.Context]())
+	_ = c.RegisterConstructor(di.Scoped(func(n doneNotifier) *scopedConsumer {
+		return &scopedConsumer{unit: &scopedUnit{}}
+	}))
+
+	scope := c.NewScope()
+	scope.Provide(reflect.TypeFor[context.Context](), context.Background())
+	_, err := scope.Resolve(reflect.TypeOf(&scopedConsumer{}))
+	if err == nil || !strings.Contains(err.Error(), "no constructor registered") {
+		t.Fatalf("스코프 값은 선언한 타입으로만 주입되어야 합니다: %v", err)
+	}
+
+	if _, err := scope.Resolve(reflect.TypeFor[context.Context]()); err != nil {
+		t.Fatalf("선언한 타입으로는 스코프 값을 주입받아야 합니다: %v", err)
+	}
+}
+
+type replicaQualifier struct{}
+
+func (replicaQualifier) Qualifier() string { return "replica" }
+
+type reportService struct {
+	repo testIface
+}
+
+type namedCycleA struct{}
+
+func TestResolve_NamedParameterSelectsQualifiedConstructor(t *testing.T) {
+	c := New()
+	if err := c.RegisterConstructor(func() *testImpl { return &testImpl{} }); err != nil {
+		t.Fatalf("생성자 등록 실패: %v", err)
+	}
+	if err := c.RegisterConstructor(di.Provide(func() *otherTestImpl { return &otherTestImpl{} }, di.WithName("replica"))); err != nil {
+		t.Fatalf("이름 있는 생성자 등록 실패: %v", err)
+	}
+	if err := c.RegisterConstructor(func(repo di.Named[testIface, replicaQualifier]) *reportService {
+		return &reportService{repo: repo.Value}
+	}); err != nil {
+		t.Fatalf("생성자 등록 실패: %v", err)
+	}
+
+	svc, err := c.Resolve(reflect.TypeOf(&reportService{}))
+	if err != nil {
+		t.Fatalf("Resolve 실패: %v", err)
+	}
+	if got := svc.(*reportService).repo.Name(); got != "other" {
+		t.Fatalf("replica 구현체가 주입되어야 합니다. got=%s", got)
+	}
+
+	// 한정자 없는 주입은 이름 없는 기본 등록을 선택해야 함
+	def, err := c.Resolve(reflect.TypeOf((*testIface)(nil)).Elem())
+	if err != nil {
+		t.Fatalf("기본 구현체 Resolve 실패: %v", err)
+	}
+	if got := def.(testIface).Name(); got != "impl" {
+		t.Fatalf("기본 구현체가 선택되어야 합니다. got=%s", got)
+	}
+}
+
+func TestResolve_PrimaryWinsAmongMultipleImplementations(t *testing.T) {
+	c := New()
+	if err := c.RegisterConstructor(di.Provide(func() *testImpl { return &testImpl{} }, di.WithName("main"))); err != nil {
+		t.Fatalf("생성자 등록 실패: %v", err)
+	}
+	if err := c.RegisterConstructor(di.Provide(func() *otherTestImpl { return &otherTestImpl{} }, di.WithName("other"), di.Primary())); err != nil {
+		t.Fatalf("생성자 등록 실패: %v", err)
+	}
+
+	instance, err := c.Resolve(reflect.TypeOf((*testIface)(nil)).Elem())
+	if err != nil {
+		t.Fatalf("Resolve 실패: %v", err)
+	}
+	if got := instance.(testIface).Name(); got != "other" {
+		t.Fatalf("Primary 구현체가 선택되어야 합니다. got=%s", got)
+	}
+}
+
+func TestResolve_NamedSingletonsAreCachedSeparately(t *testing.T) {
+	c := New()
+	if err := c.RegisterConstructor(di.Provide(func() *testService { return &testService{} }, di.WithName("a"))); err != nil {
+		t.Fatalf("생성자 등록 실패: %v", err)
+	}
+	if err := c.RegisterConstructor(di.Provide(func() *testService { return &testService{} }, di.WithName("b"))); err != nil {
+		t.Fatalf("생성자 등록 실패: %v", err)
+	}
+
+	var a, b *testService
+	if err := c.RegisterConstructor(func(x di.Named[*testService, nameA], y di.Named[*testService, nameB]) *testHandler {
+		a, b = x.Value, y.Value
+		return &testHandler{svc: x.Value}
+	}); err != nil {
+		t.Fatalf("생성자 등록 실패: %v", err)
+	}
+
+	if _, err := c.Resolve(reflect.TypeOf(&testHandler{})); err != nil {
+		t.Fatalf("Resolve 실패: %v", err)
+	}
+	if a == nil || b == nil || a == b {
+		t.Fatalf("이름별로 다른 인스턴스가 주입되어야 합니다.")
+	}
+
+	// 이름 없이 요청하면 후보가 여러 개이므로 실패해야 함
+	if _, err := c.Resolve(reflect.TypeOf(&testService{})); err == nil || !strings.Contains(err.Error(), "multiple constructors") {
+		t.Fatalf("모호한 등록 에러가 발생해야 합니다. got=%v", err)
+	}
+}
+
+type nameA struct{}
+
+func (nameA) Qualifier() string { return "a" }
+
+type nameB struct{}
+
+func (nameB) Qualifier() string { return "b" }
+
+func TestWarmUp_DetectsCycleThroughNamedParameter(t *testing.T) {
+	c := New()
+	if err := c.RegisterConstructor(di.Provide(func(_ di.Named[*namedCycleA, replicaQualifier]) *namedCycleA {
+		return &namedCycleA{}
+	}, di.WithName("replica"))); err != nil {
+		t.Fatalf("생성자 등록 실패: %v", err)
+	}
+
+	err := c.WarmUp([]reflect.Type{reflect.TypeFor[di.Named[*namedCycleA, replicaQualifier]]()})
+	if err == nil || !strings.Contains(err.Error(), "circular dependency detected") {
+		t.Fatalf("순환 의존성 에러가 발생해야 합니다. got=%v", err)
+	}
+	if !strings.Contains(err.Error(), `"replica"`) {
+		t.Fatalf("순환 경로에 한정자 이름이 포함되어야 합니다. got=%v", err)
+	}
+}
+
+func TestResolve_NamedParameterWithoutRegistrationReturnsError(t *testing.T) {
+	c := New()
+	if err := c.RegisterConstructor(func() *testImpl { return &testImpl{} }); err != nil {
+		t.Fatalf("생성자 등록 실패: %v", err)
+	}
+
+	_, err := c.Resolve(reflect.TypeFor[di.Named[testIface, replicaQualifier]]())
+	if err == nil || !strings.Contains(err.Error(), `named "replica"`) {
+		t.Fatalf("이름 있는 등록 누락 에러가 발생해야 합니다. got=%v", err)
+	}
+}
+
+type pointerQualifier struct{}
+
+func (*pointerQualifier) Qualifier() string { return "replica" }
+
+type valuePointerQualifier struct{}
+
+func (valuePointerQualifier) Qualifier() string { return "replica" }
+
+func TestResolve_NamedParameterAcceptsPointerQualifier(t *testing.T) {
+	c := New()
+	if err := c.RegisterConstructor(di.Provide(func() *otherTestImpl { return &otherTestImpl{} }, di.WithName("replica"))); err != nil {
+		t.Fatalf("이름 있는 생성자 등록 실패: %v", err)
+	}
+
+	for _, typ := range []reflect.Type{
+		reflect.TypeFor[di.Named[testIface, *pointerQualifier]](),
+		reflect.TypeFor[di.Named[testIface, *valuePointerQualifier]](),
+	} {
+		instance, err := c.Resolve(typ)
+		if err != nil {
+			t.Fatalf("포인터 한정자 Resolve 실패(%v): %v", typ, err)
+		}
+		if got := reflect.ValueOf(instance).Field(0).Interface().(testIface).Name(); got != "other" {
+			t.Fatalf("replica 구현체가 주입되어야 합니다. got=%s", got)
+		}
+	}
+}
+
+// targetLookalike는 di.Named가 아니지만 Target 메서드를 가진 구조체입니다.
+type targetLookalike struct{}
+
+func (targetLookalike) Target() (reflect.Type, string) {
+	return reflect.TypeFor[*testImpl](), "replica"
+}
+
+func TestResolve_OnlyDINamedIsTreatedAsQualifier(t *testing.T) {
+	c := New()
+	if err := c.RegisterConstructor(func() targetLookalike { return targetLookalike{} }); err != nil {
+		t.Fatalf("생성자 등록 실패: %v", err)
+	}
+
+	instance, err := c.Resolve(reflect.TypeFor[targetLookalike]())
+	if err != nil {
+		t.Fatalf("Target 메서드를 가진 일반 구조체는 그대로 주입되어야 합니다: %v", err)
+	}
+	if _, ok := instance.(targetLookalike); !ok {
+		t.Fatalf("예상하지 못한 인스턴스입니다: %T", instance)
+	}
+}
+
+func TestResolve_ReRegistrationInvalidatesCachedSelection(t *testing.T) {
+	c := New()
+	_ = c.RegisterConstructor(di.Transient(func() testIface { return &testImpl{} }))
+
+	first, err := c.Resolve(reflect.TypeFor[testIface]())
+	if err != nil {
+		t.Fatalf("Resolve 실패: %v", err)
+	}
+	if got := first.(testIface).Name(); got != "impl" {
+		t.Fatalf("예상하지 못한 구현체입니다: %s", got)
+	}
+
+	_ = c.RegisterConstructor(di.Transient(func() testIface { return &otherTestImpl{} }))
+	second, err := c.Resolve(reflect.TypeFor[testIface]())
+	if err != nil {
+		t.Fatalf("Resolve 실패: %v", err)
+	}
+	if got := second.(testIface).Name(); got != "other" {
+		t.Fatalf("교체된 생성자가 선택되어야 합니다. got=%s", got)
+	}
+}
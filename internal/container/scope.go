@@ -0,0 +1,114 @@
+package container
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/NARUBROWN/spine/pkg/di"
+)
+
+/*
+Scope는 하나의 ExecutionContext 동안 유지되는 인스턴스 저장소입니다.
+Scoped 컴포넌트는 스코프마다 한 번 생성되며, Close 시 생성 역순으로 정리됩니다.
+*/
+type Scope struct {
+	container   *Container
+	mu          sync.Mutex
+	instances   map[dependency]any
+	disposables []any
+	closed      bool
+}
+
+func (c *Container) NewScope() *Scope {
+	return &Scope{
+		container: c,
+		instances: make(map[dependency]any),
+	}
+}
+
+// Provide는 스코프가 직접 제공하는 값을 등록합니다.
+// 해당 타입은 RegisterScopedValue로 선언되어 있어야 다른 생성자가 의존할 수 있습니다.
+func (s *Scope) Provide(valueType reflect.Type, value any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.instances[dependency{typ: valueType}] = value
+}
+
+func (s *Scope) Resolve(componentType reflect.Type) (any, error) {
+	return s.container.resolveIn(s, componentType)
+}
+
+func (s *Scope) resolve(reg *registration, dep dependency, stack map[dependency]int, path []dependency) (any, error) {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("execution scope is already closed (%v)", reg.key())
+	}
+	if instance, ok := s.instances[reg.key()]; ok {
+		s.mu.Unlock()
+		return instance, nil
+	}
+	s.mu.Unlock()
+
+	result, err := s.container.construct(s, reg, dep, stack, path)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.instances[reg.key()]; ok {
+		return existing, nil
+	}
+	s.instances[reg.key()] = result
+	s.disposables = append(s.disposables, result)
+	return result, nil
+}
+
+func (s *Scope) track(instance any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.disposables = append(s.disposables, instance)
+}
+
+// Close는 스코프에서 생성된 인스턴스를 생성 역순으로 정리합니다.
+// err는 실행 결과로, di.Disposer 구현체에 그대로 전달됩니다.
+func (s *Scope) Close(err error) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	disposables := s.disposables
+	s.disposables = nil
+	s.mu.Unlock()
+
+	var errs []error
+	for i := len(disposables) - 1; i >= 0; i-- {
+		if disposeErr := dispose(disposables[i], err); disposeErr != nil {
+			errs = append(errs, fmt.Errorf("failed to dispose %T: %w", disposables[i], disposeErr))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func dispose(instance any, err error) (disposeErr error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			disposeErr = fmt.Errorf("panic while disposing: %v", recovered)
+		}
+	}()
+
+	switch v := instance.(type) {
+	case di.Disposer:
+		return v.Dispose(err)
+	case io.Closer:
+		return v.Close()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/NARUBROWN/spine/core"
+	"github.com/NARUBROWN/spine/internal/event/publish"
+	"github.com/NARUBROWN/spine/pkg/event/codec"
+)
+
+var errorType = reflect.TypeFor[error]()
+
+type replier interface {
+	IsRequest() bool
+	SetReply(reply publish.Reply)
+}
+
+/*
+ReplyReturnHandler는 Consumer 핸들러의 반환값을 요청(Request/Reply) 메시지의 응답으로 보냅니다.
+
+  - []byte는 그대로, string은 text/plain으로, 그 외 값은 JSON으로 보냅니다.
+  - 요청이 아닌 메시지의 반환값은 무시합니다.
+  - 응답은 바로 보내지 않고 실행 context에 남기며, 후속 처리(PostExecutionHook, 스코프 종료)까지 성공한 뒤에 Runtime이 보냅니다.
+*/
+type ReplyReturnHandler struct{}
+
+func (h *ReplyReturnHandler) Supports(returnType reflect.Type) bool {
+	// 에러 반환값은 실패로 처리되어야 하므로 응답으로 삼지 않는다.
+	return !returnType.Implements(errorType)
+}
+
+func (h *ReplyReturnHandler) Handle(value any, ctx core.ExecutionContext) error {
+	r, ok := ctx.(replier)
+	if !ok || !r.IsRequest() {
+		return nil
+	}
+
+	reply, err := newReply(value)
+	if err != nil {
+		return err
+	}
+	r.SetReply(reply)
+	return nil
+}
+
+func newReply(value any) (publish.Reply, error) {
+	switch v := value.(type) {
+	case []byte:
+		return publish.Reply{
+			Body:    v,
+			Headers: map[string]string{codec.ContentTypeHeader: "application/octet-stream"},
+		}, nil
+	case string:
+		return publish.Reply{
+			Body:    []byte(v),
+			Headers: map[string]string{codec.ContentTypeHeader: "text/plain; charset=utf-8"},
+		}, nil
+	}
+
+	body, err := json.Marshal(value)
+	if err != nil {
+		return publish.Reply{}, fmt.Errorf("ReplyReturnHandler: serialization failed: %w", err)
+	}
+	return publish.Reply{
+		Body:    body,
+		Headers: map[string]string{codec.ContentTypeHeader: "application/json"},
+	}, nil
+}
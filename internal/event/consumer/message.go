@@ -1,16 +1,50 @@
 package consumer
 
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"github.com/NARUBROWN/spine/internal/event/publish"
+	"github.com/NARUBROWN/spine/pkg/event"
+)
+
 type Message struct {
 	EventName string
 	Payload   []byte
-	Metadata  map[string]string
+	Metadata  event.Metadata
+
+	// 동시 처리 시 같은 값끼리 순서를 지킬 키 (비어 있으면 아무 worker나 처리)
+	orderingKey string
 
 	// ACK/NACK 콜백 함수 (선택적)
 	// Reader 구현체에서 설정하며, Runtime에서 처리 결과에 따라 호출
-	ack  func() error
-	nack func() error
+	ack        func() error
+	nack       func() error
+	reject     func() error
+	deadLetter func(ctx context.Context, letter DeadLetter) error
+	// 요청(Request/Reply) 메시지일 때만 설정된다.
+	reply   func(ctx context.Context, reply publish.Reply) error
+	replied atomic.Bool
+}
+
+// DeadLetter는 재시도를 모두 소진한 메시지를 Dead Letter로 보낼 때 함께 기록하는 정보입니다.
+type DeadLetter struct {
+	// Dead Letter 토픽 또는 큐 이름
+	Destination string
+	// 마지막 실패 원인
+	Err error
+	// 수행한 시도 횟수
+	Attempts int
 }
 
+// Dead Letter 메시지의 헤더(메타데이터) 키
+const (
+	DeadLetterErrorHeader       = "x-spine-error"
+	DeadLetterAttemptsHeader    = "x-spine-attempts"
+	DeadLetterOriginTopicHeader = "x-spine-original-topic"
+)
+
 // Ack는 메시지 처리 성공을 메시지 브로커에 알립니다.
 func (m *Message) Ack() error {
 	if m.ack != nil {
@@ -36,3 +70,71 @@ func (m *Message) SetAckHandler(ack func() error) {
 func (m *Message) SetNackHandler(nack func() error) {
 	m.nack = nack
 }
+
+// Reject는 메시지를 재전달 없이 폐기하도록 브로커에 알립니다.
+// Reject 핸들러가 없으면 Ack로 처리합니다.
+func (m *Message) Reject() error {
+	if m.reject != nil {
+		return m.reject()
+	}
+	return m.Ack()
+}
+
+// SendToDeadLetter는 메시지를 Dead Letter로 보냅니다. 원본 메시지의 ACK는 호출자가 책임집니다.
+func (m *Message) SendToDeadLetter(ctx context.Context, letter DeadLetter) error {
+	if m.deadLetter == nil {
+		return errors.New("dead letter is not supported by this reader")
+	}
+	return m.deadLetter(ctx, letter)
+}
+
+// OrderingKey는 동시 처리 시 순서를 지킬 키를 반환합니다.
+func (m *Message) OrderingKey() string {
+	return m.orderingKey
+}
+
+// SetOrderingKey는 동시 처리 시 순서를 지킬 키를 설정합니다 (Reader 구현체용).
+func (m *Message) SetOrderingKey(key string) {
+	m.orderingKey = key
+}
+
+// SetRejectHandler는 재전달 없는 폐기 콜백 함수를 설정합니다 (Reader 구현체용).
+func (m *Message) SetRejectHandler(reject func() error) {
+	m.reject = reject
+}
+
+// SetDeadLetterHandler는 Dead Letter 전송 콜백 함수를 설정합니다 (Reader 구현체용).
+func (m *Message) SetDeadLetterHandler(deadLetter func(ctx context.Context, letter DeadLetter) error) {
+	m.deadLetter = deadLetter
+}
+
+// IsRequest는 응답을 기다리는 요청 메시지인지 여부를 반환합니다.
+func (m *Message) IsRequest() bool {
+	return m.reply != nil
+}
+
+// Reply는 요청을 보낸 쪽에 응답을 보냅니다. 요청 하나에는 한 번만 응답할 수 있습니다.
+func (m *Message) Reply(ctx context.Context, reply publish.Reply) error {
+	if m.reply == nil {
+		return errors.New("message is not a request")
+	}
+	if !m.replied.CompareAndSwap(false, true) {
+		return errors.New("request has already been replied to")
+	}
+	if err := m.reply(ctx, reply); err != nil {
+		// 전송하지 못했으면 재시도에서 다시 응답할 수 있게 한다.
+		m.replied.Store(false)
+		return err
+	}
+	return nil
+}
+
+// Replied는 이미 응답을 보냈는지 여부를 반환합니다.
+func (m *Message) Replied() bool {
+	return m.replied.Load()
+}
+
+// SetReplyHandler는 응답 전송 콜백 함수를 설정합니다 (Reader 구현체용).
+func (m *Message) SetReplyHandler(reply func(ctx context.Context, reply publish.Reply) error) {
+	m.reply = reply
+}
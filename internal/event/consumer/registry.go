@@ -6,11 +6,13 @@ import (
 
 	"github.com/NARUBROWN/spine/core"
 	"github.com/NARUBROWN/spine/internal/router"
+	"github.com/NARUBROWN/spine/pkg/event/consume"
 )
 
 type Registration struct {
-	Topic string
-	Meta  core.HandlerMeta
+	Topic   string
+	Meta    core.HandlerMeta
+	Options consume.Options
 }
 
 type Registry struct {
@@ -24,7 +26,7 @@ func NewRegistry() *Registry {
 	}
 }
 
-func (r *Registry) Register(topic string, target any) error {
+func (r *Registry) Register(topic string, target any, opts ...consume.Option) error {
 	if topic == "" {
 		return fmt.Errorf("consumer: topic cannot be empty")
 	}
@@ -38,11 +40,20 @@ func (r *Registry) Register(topic string, target any) error {
 		return err
 	}
 
+	var options consume.Options
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.Dedup != nil && options.Dedup.Store == nil {
+		return fmt.Errorf("consumer: dedup store cannot be nil (topic=%s)", topic)
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.registrations = append(r.registrations, Registration{
-		Topic: topic,
-		Meta:  meta,
+		Topic:   topic,
+		Meta:    meta,
+		Options: options,
 	})
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/NARUBROWN/spine/core"
 	"github.com/NARUBROWN/spine/internal/event/publish"
+	"github.com/NARUBROWN/spine/pkg/event"
 )
 
 type ConsumerRequestContextImpl struct {
@@ -12,6 +13,7 @@ type ConsumerRequestContextImpl struct {
 	msg      *Message
 	eventBus publish.EventBus
 	store    map[string]any
+	reply    *publish.Reply
 }
 
 func NewRequestContext(
@@ -60,9 +62,13 @@ func (c *ConsumerRequestContextImpl) Set(key string, value any) {
 	c.store[key] = value
 }
 
+func (c *ConsumerRequestContextImpl) Metadata() event.Metadata {
+	return c.msg.Metadata
+}
+
 func (c *ConsumerRequestContextImpl) Header(key string) string {
-	// Consumer 실행 컨텍스트에는 HTTP Header 개념이 없으므로 항상 빈 문자열을 반환합니다.
-	return ""
+	// 메시지 헤더를 HTTP Header처럼 노출해 Interceptor(인증, 트레이싱 등)를 그대로 재사용할 수 있게 합니다.
+	return c.msg.Metadata.Header(key)
 }
 
 func (c *ConsumerRequestContextImpl) Method() string {
@@ -86,3 +92,21 @@ func (c *ConsumerRequestContextImpl) PathKeys() []string {
 func (c *ConsumerRequestContextImpl) Queries() map[string][]string {
 	return nil
 }
+
+// IsRequest는 처리 중인 메시지가 응답을 기다리는 요청인지 여부를 반환합니다.
+func (c *ConsumerRequestContextImpl) IsRequest() bool {
+	return c.msg.IsRequest()
+}
+
+// SetReply는 처리 중인 요청 메시지에 보낼 응답을 남깁니다. 응답은 실행이 모두 성공한 뒤에 보냅니다.
+func (c *ConsumerRequestContextImpl) SetReply(reply publish.Reply) {
+	c.reply = &reply
+}
+
+// pendingReply는 핸들러가 남긴 응답을 반환합니다.
+func (c *ConsumerRequestContextImpl) pendingReply() (publish.Reply, bool) {
+	if c.reply == nil {
+		return publish.Reply{}, false
+	}
+	return *c.reply, true
+}
@@ -1,18 +1,33 @@
 package resolver
 
 import (
-	"encoding/json"
 	"fmt"
 	"reflect"
 
 	"github.com/NARUBROWN/spine/core"
 	"github.com/NARUBROWN/spine/internal/resolver"
+	"github.com/NARUBROWN/spine/pkg/event/codec"
+	"github.com/NARUBROWN/spine/pkg/validate"
+	"google.golang.org/protobuf/proto"
 )
 
-type DTOResolver struct{}
+/*
+DTOResolver는 메시지 본문을 DTO로 역직렬화합니다.
+메시지의 content-type 헤더로 Codec을 고르며, Codecs가 nil이면 기본 Codec(JSON, Protobuf, CloudEvents)만 사용합니다.
+*/
+type DTOResolver struct {
+	Codecs *codec.Registry
+}
+
+// protobuf 생성 타입은 포인터로 다루므로 *T 파라미터도 받는다.
+var protoMessageType = reflect.TypeFor[proto.Message]()
 
 func (r *DTOResolver) Supports(meta resolver.ParameterMeta) bool {
-	return meta.Type.Kind() == reflect.Struct
+	if meta.Type.Kind() == reflect.Pointer {
+		return meta.Type.Elem().Kind() == reflect.Struct && meta.Type.Implements(protoMessageType)
+	}
+	// event.Metadata는 MetadataResolver가 처리한다.
+	return meta.Type.Kind() == reflect.Struct && meta.Type != metadataType
 }
 
 func (r *DTOResolver) Resolve(ctx core.ExecutionContext, meta resolver.ParameterMeta) (any, error) {
@@ -27,11 +42,27 @@ func (r *DTOResolver) Resolve(ctx core.ExecutionContext, meta resolver.Parameter
 	}
 
 	// DTO 인스턴스 생성
-	dtoPtr := reflect.New(meta.Type)
+	dtoType := meta.Type
+	if dtoType.Kind() == reflect.Pointer {
+		dtoType = dtoType.Elem()
+	}
+	dtoPtr := reflect.New(dtoType)
 
-	if err := json.Unmarshal(payload, dtoPtr.Interface()); err != nil {
+	msg := codec.Message{Body: payload}
+	if carrier, ok := ctx.(core.MetadataCarrier); ok {
+		msg.Headers = carrier.Metadata().Headers
+	}
+
+	if err := r.Codecs.Decode(consumerCtx.EventName(), msg, dtoPtr.Interface()); err != nil {
 		return nil, fmt.Errorf("DTO deserialization failed: %w", err)
 	}
 
+	if err := validate.Struct(dtoPtr.Interface()); err != nil {
+		return nil, fmt.Errorf("DTO validation failed: %w", err)
+	}
+
+	if meta.Type.Kind() == reflect.Pointer {
+		return dtoPtr.Interface(), nil
+	}
 	return dtoPtr.Elem().Interface(), nil
 }
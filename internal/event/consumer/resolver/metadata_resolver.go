@@ -0,0 +1,27 @@
+package resolver
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/NARUBROWN/spine/core"
+	"github.com/NARUBROWN/spine/internal/resolver"
+	"github.com/NARUBROWN/spine/pkg/event"
+)
+
+var metadataType = reflect.TypeFor[event.Metadata]()
+
+// MetadataResolver는 event.Metadata 파라미터에 메시지의 브로커 메타데이터를 주입합니다.
+type MetadataResolver struct{}
+
+func (r *MetadataResolver) Supports(meta resolver.ParameterMeta) bool {
+	return meta.Type == metadataType
+}
+
+func (r *MetadataResolver) Resolve(ctx core.ExecutionContext, meta resolver.ParameterMeta) (any, error) {
+	carrier, ok := ctx.(core.MetadataCarrier)
+	if !ok {
+		return nil, fmt.Errorf("context does not carry message metadata")
+	}
+	return carrier.Metadata(), nil
+}
@@ -2,17 +2,23 @@ package resolver
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 
 	"github.com/NARUBROWN/spine/core"
 	internalpublish "github.com/NARUBROWN/spine/internal/event/publish"
 	internalresolver "github.com/NARUBROWN/spine/internal/resolver"
+	"github.com/NARUBROWN/spine/pkg/event"
+	"github.com/NARUBROWN/spine/pkg/validate"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type testConsumerContext struct {
 	eventName string
 	payload   []byte
+	metadata  event.Metadata
 	store     map[string]any
 }
 
@@ -36,6 +42,7 @@ func (c *testConsumerContext) Set(key string, value any)    { c.store[key] = val
 func (c *testConsumerContext) Get(key string) (any, bool)   { v, ok := c.store[key]; return v, ok }
 func (c *testConsumerContext) EventName() string            { return c.eventName }
 func (c *testConsumerContext) Payload() []byte              { return c.payload }
+func (c *testConsumerContext) Metadata() event.Metadata     { return c.metadata }
 
 type nonConsumerContext struct{}
 
@@ -130,3 +137,101 @@ func TestDTOResolver(t *testing.T) {
 		t.Fatal("ConsumerRequestContext가 아니면 에러여야 합니다")
 	}
 }
+
+func TestDTOResolver_ValidationFailureReturnsFieldErrors(t *testing.T) {
+	type orderCreated struct {
+		OrderID string `json:"orderId" validate:"required"`
+		Amount  int    `json:"amount" validate:"min=1"`
+	}
+
+	r := &DTOResolver{}
+	pm := internalresolver.ParameterMeta{Type: reflect.TypeFor[orderCreated]()}
+
+	_, err := r.Resolve(newTestConsumerContext("order.created", []byte(`{"amount":0}`)), pm)
+	var fieldErrs validate.Errors
+	if !errors.As(err, &fieldErrs) || len(fieldErrs) != 2 {
+		t.Fatalf("검증 실패 필드 에러가 반환되어야 합니다: %v", err)
+	}
+
+	val, err := r.Resolve(newTestConsumerContext("order.created", []byte(`{"orderId":"o-1","amount":3}`)), pm)
+	if err != nil {
+		t.Fatalf("유효한 payload는 통과해야 합니다: %v", err)
+	}
+	if val.(orderCreated).OrderID != "o-1" {
+		t.Fatalf("DTO 변환 결과가 잘못되었습니다: %+v", val)
+	}
+}
+
+func TestMetadataResolver(t *testing.T) {
+	r := &MetadataResolver{}
+	pm := internalresolver.ParameterMeta{Type: reflect.TypeOf(event.Metadata{})}
+
+	if !r.Supports(pm) {
+		t.Fatal("event.Metadata는 MetadataResolver가 지원해야 합니다")
+	}
+	if (&DTOResolver{}).Supports(pm) {
+		t.Fatal("event.Metadata는 DTOResolver가 가로채면 안 됩니다")
+	}
+
+	ctx := newTestConsumerContext("order.created", nil)
+	ctx.metadata = event.Metadata{Key: "order-1", Partition: 3, Offset: 42}
+
+	got, err := r.Resolve(ctx, pm)
+	if err != nil {
+		t.Fatalf("Resolve 실패: %v", err)
+	}
+	meta := got.(event.Metadata)
+	if meta.Key != "order-1" || meta.Partition != 3 || meta.Offset != 42 {
+		t.Fatalf("metadata가 잘못되었습니다: %+v", meta)
+	}
+
+	if _, err := r.Resolve(&nonConsumerContext{}, pm); err == nil {
+		t.Fatal("metadata가 없는 context는 에러여야 합니다")
+	}
+}
+
+func TestDTOResolver_DecodesStructuredCloudEvent(t *testing.T) {
+	type orderDTO struct {
+		OrderID string `json:"orderId"`
+	}
+
+	ctx := newTestConsumerContext("order.created", []byte(
+		`{"specversion":"1.0","id":"1","source":"/orders","type":"order.created","datacontenttype":"application/json","data":{"orderId":"o-1"}}`,
+	))
+	ctx.metadata = event.Metadata{Headers: map[string]string{"Content-Type": "application/cloudevents+json"}}
+
+	val, err := (&DTOResolver{}).Resolve(ctx, internalresolver.ParameterMeta{Type: reflect.TypeOf(orderDTO{})})
+	if err != nil {
+		t.Fatalf("Resolve 실패: %v", err)
+	}
+	if val.(orderDTO).OrderID != "o-1" {
+		t.Fatalf("CloudEvents data가 DTO로 역직렬화되어야 합니다: %+v", val)
+	}
+}
+
+func TestDTOResolver_DecodesProtobufPointer(t *testing.T) {
+	body, err := proto.Marshal(wrapperspb.String("o-1"))
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	ctx := newTestConsumerContext("order.created", body)
+	ctx.metadata = event.Metadata{Headers: map[string]string{"content-type": "application/protobuf"}}
+
+	pm := internalresolver.ParameterMeta{Type: reflect.TypeOf(&wrapperspb.StringValue{})}
+	r := &DTOResolver{}
+	if !r.Supports(pm) {
+		t.Fatal("proto.Message 포인터는 DTOResolver가 지원해야 합니다")
+	}
+	val, err := r.Resolve(ctx, pm)
+	if err != nil {
+		t.Fatalf("Resolve 실패: %v", err)
+	}
+	if val.(*wrapperspb.StringValue).GetValue() != "o-1" {
+		t.Fatalf("protobuf 본문이 역직렬화되어야 합니다: %v", val)
+	}
+
+	if r.Supports(internalresolver.ParameterMeta{Type: reflect.TypeOf(&struct{}{})}) {
+		t.Fatal("proto.Message가 아닌 포인터는 지원하면 안 됩니다")
+	}
+}
@@ -2,13 +2,23 @@ package consumer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"sync"
+	"time"
 
+	"github.com/NARUBROWN/spine/core"
+	"github.com/NARUBROWN/spine/internal/event/publish"
 	"github.com/NARUBROWN/spine/internal/pipeline"
+	"github.com/NARUBROWN/spine/pkg/event/consume"
+	eventpublish "github.com/NARUBROWN/spine/pkg/event/publish"
+	"github.com/NARUBROWN/spine/pkg/httperr"
 )
 
+// 호출자에게 보여줄 메시지가 없는 실패에 담는 응답 메시지
+const defaultReplyErrorMessage = "request processing failed"
+
 type runnerFactory interface {
 	Build(reg Registration) (Reader, error)
 }
@@ -18,6 +28,9 @@ type Runtime struct {
 	factory  runnerFactory
 	pipeline *pipeline.Pipeline
 	stopOnce sync.Once
+	mu       sync.Mutex
+	stopped  bool
+	wg       sync.WaitGroup
 	cancel   context.CancelFunc
 	errChan  chan error
 	done     chan struct{}
@@ -55,74 +68,316 @@ func (r *Runtime) Done() <-chan struct{} {
 }
 
 func (r *Runtime) Start(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stopped {
+		return
+	}
+
 	ctx, r.cancel = context.WithCancel(ctx)
 	for _, registration := range r.registry.Registrations() {
 		log.Printf("[Event Consumer] Starting consumer for topic '%s'", registration.Topic)
-		go func(reg Registration) {
-			reader, err := r.factory.Build(reg)
-			if err != nil {
-				startErr := fmt.Errorf(
-					"[Event Consumer] Consumer initialization failed (topic=%s): %w",
-					reg.Topic,
-					err,
-				)
-				select {
-				case r.errChan <- startErr:
-				default:
-					log.Printf("%v (could not forward because the error channel is full)", startErr)
-				}
-				// 초기화 실패는 치명적이므로 전체 런타임을 중단한다.
-				r.Stop()
-				return
+		r.wg.Add(1)
+		go r.consume(ctx, registration)
+	}
+}
+
+// consume은 Registration 하나의 Reader를 열고, 종료 시 처리 중인 메시지를 모두 끝낸 뒤 Reader를 닫습니다.
+func (r *Runtime) consume(ctx context.Context, reg Registration) {
+	defer r.wg.Done()
+
+	reader, err := r.factory.Build(reg)
+	if err != nil {
+		startErr := fmt.Errorf(
+			"[Event Consumer] Consumer initialization failed (topic=%s): %w",
+			reg.Topic,
+			err,
+		)
+		select {
+		case r.errChan <- startErr:
+		default:
+			log.Printf("%v (could not forward because the error channel is full)", startErr)
+		}
+		// 초기화 실패는 치명적이므로 전체 런타임을 중단한다.
+		// Stop은 이 goroutine의 종료를 기다리므로 별도 goroutine에서 호출한다.
+		go r.Stop()
+		return
+	}
+	defer reader.Close()
+
+	handle := func(msg *Message) {
+		r.handle(ctx, reg, msg)
+	}
+	dispatch := handle
+
+	if concurrency := reg.Options.Concurrency; concurrency > 1 {
+		pool := newWorkerPool(concurrency, handle)
+		// reader.Close보다 먼저 실행되어 처리 중인 메시지를 모두 끝낸다.
+		defer pool.drain()
+
+		dispatch = func(msg *Message) {
+			if !pool.submit(ctx, msg) {
+				// 종료 중 worker에 넘기지 못한 메시지는 브로커에 돌려보낸다.
+				r.nack(reg, msg)
 			}
-			defer reader.Close()
+		}
+	}
 
-			for {
-				select {
-				case <-ctx.Done():
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			msg, err := reader.Read(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
 					return
-				default:
-					msg, err := reader.Read(ctx)
-					if err != nil {
-						if ctx.Err() != nil {
-							return
-						}
-						log.Printf("[Event Consumer] Failed to read message: %v", err)
-						continue
-					}
-
-					// Consumer ExecutionContext 생성
-					reqCtx := NewRequestContext(ctx, msg, nil)
-
-					// 핸들러 실행
-					if err := r.pipeline.Execute(reqCtx); err != nil {
-						log.Printf(
-							"[Event Consumer] Handler execution failed (%s): %v",
-							reg.Topic,
-							err,
-						)
-						// 핸들러 실패 시 NACK
-						if nackErr := msg.Nack(); nackErr != nil {
-							log.Printf(
-								"[Event Consumer] NACK failed (%s): %v",
-								reg.Topic,
-								nackErr,
-							)
-						}
-						continue
-					}
-
-					// 핸들러 성공 시 ACK
-					if ackErr := msg.Ack(); ackErr != nil {
-						log.Printf(
-							"[Event Consumer] ACK failed (%s): %v",
-							reg.Topic,
-							ackErr,
-						)
-					}
 				}
+				log.Printf("[Event Consumer] Failed to read message: %v", err)
+				continue
+			}
+
+			dispatch(msg)
+		}
+	}
+}
+
+// handle은 메시지 하나를 처리하고, 결과와 재시도 정책에 따라 ACK/NACK/Dead Letter를 결정합니다.
+func (r *Runtime) handle(ctx context.Context, reg Registration, msg *Message) {
+	policy := reg.Options.Retry
+
+	// 이미 처리한 메시지는 핸들러를 실행하지 않고 ACK 한다.
+	dedupKey := r.dedupKey(reg, msg)
+	if dedupKey != "" && r.isDuplicate(ctx, reg, dedupKey) {
+		log.Printf("[Event Consumer] Skipping duplicate message (%s, key=%s)", reg.Topic, dedupKey)
+		if ackErr := msg.Ack(); ackErr != nil {
+			log.Printf("[Event Consumer] ACK failed (%s): %v", reg.Topic, ackErr)
+		}
+		return
+	}
+
+	// 이미 받은 메시지는 Stop 중에도 끝까지 처리하도록 종료 신호를 전파하지 않는다.
+	baseCtx := context.WithoutCancel(ctx)
+
+	// 요청 메시지는 호출자가 응답을 기다리는 마감 시각까지만 처리한다.
+	if deadline, ok := requestDeadline(msg); ok {
+		if !time.Now().Before(deadline) {
+			log.Printf("[Event Consumer] Skipping expired request (%s, deadline=%s)", reg.Topic, deadline.Format(time.RFC3339Nano))
+			if ackErr := msg.Ack(); ackErr != nil {
+				log.Printf("[Event Consumer] ACK failed (%s): %v", reg.Topic, ackErr)
+			}
+			return
+		}
+
+		var cancel context.CancelFunc
+		baseCtx, cancel = context.WithDeadline(baseCtx, deadline)
+		defer cancel()
+	}
+
+	attempt := 0
+	for {
+		attempt++
+
+		// Consumer ExecutionContext 생성
+		reqCtx := NewRequestContext(baseCtx, msg, nil)
+
+		// 핸들러 실행
+		err := r.pipeline.Execute(reqCtx)
+		if err == nil {
+			// 후속 처리까지 모두 성공한 뒤에만 응답을 보낸다.
+			r.replySuccess(baseCtx, reg, msg, reqCtx)
+			// 성공한 메시지만 처리 완료로 기록한다.
+			if dedupKey != "" {
+				r.markProcessed(ctx, reg, dedupKey)
+			}
+			// 핸들러 성공 시 ACK
+			if ackErr := msg.Ack(); ackErr != nil {
+				log.Printf("[Event Consumer] ACK failed (%s): %v", reg.Topic, ackErr)
+			}
+			return
+		}
+
+		log.Printf(
+			"[Event Consumer] Handler execution failed (%s, attempt %d): %v",
+			reg.Topic,
+			attempt,
+			err,
+		)
+
+		// 정책이 없으면 브로커의 재전달에 맡긴다.
+		if policy == nil {
+			r.nack(reg, msg)
+			return
+		}
+
+		if attempt < policy.Attempts() && policy.IsRetryable(err) {
+			timer := time.NewTimer(policy.Backoff(attempt))
+			select {
+			case <-ctx.Done():
+				// 종료 중에는 재시도를 멈추고 브로커가 다시 전달하도록 한다.
+				timer.Stop()
+				r.nack(reg, msg)
+				return
+			case <-timer.C:
 			}
-		}(registration)
+			continue
+		}
+
+		r.giveUp(ctx, reg, msg, *policy, err, attempt)
+		return
+	}
+}
+
+// giveUp은 더 이상 재시도하지 않을 메시지를 Dead Letter로 보내거나 폐기합니다.
+func (r *Runtime) giveUp(ctx context.Context, reg Registration, msg *Message, policy consume.RetryPolicy, cause error, attempts int) {
+	r.replyError(ctx, reg, msg, cause)
+
+	if policy.DeadLetter == "" {
+		log.Printf(
+			"[Event Consumer] Discarding message after %d attempt(s) (%s): %v",
+			attempts,
+			reg.Topic,
+			cause,
+		)
+		if rejectErr := msg.Reject(); rejectErr != nil {
+			log.Printf("[Event Consumer] Reject failed (%s): %v", reg.Topic, rejectErr)
+		}
+		return
+	}
+
+	letter := DeadLetter{
+		Destination: policy.DeadLetter,
+		Err:         cause,
+		Attempts:    attempts,
+	}
+	// 종료 중이어도 Dead Letter 전송은 마무리한다.
+	if dlqErr := msg.SendToDeadLetter(context.WithoutCancel(ctx), letter); dlqErr != nil {
+		log.Printf(
+			"[Event Consumer] Dead letter routing failed (%s -> %s): %v",
+			reg.Topic,
+			policy.DeadLetter,
+			dlqErr,
+		)
+		// 원본을 잃지 않도록 브로커에 돌려보낸다.
+		r.nack(reg, msg)
+		return
+	}
+
+	log.Printf(
+		"[Event Consumer] Routed message to dead letter '%s' after %d attempt(s) (%s)",
+		policy.DeadLetter,
+		attempts,
+		reg.Topic,
+	)
+	if ackErr := msg.Ack(); ackErr != nil {
+		log.Printf("[Event Consumer] ACK failed (%s): %v", reg.Topic, ackErr)
+	}
+}
+
+/*
+dedupKey는 중복 검사에 사용할 키를 반환합니다.
+같은 저장소를 공유해도 토픽과 핸들러마다 따로 기록되도록 "토픽:컨트롤러.메서드:" 를 앞에 붙입니다.
+그래야 한 토픽을 구독하는 여러 핸들러가 서로의 처리 기록 때문에 메시지를 건너뛰지 않습니다.
+*/
+func (r *Runtime) dedupKey(reg Registration, msg *Message) string {
+	policy := reg.Options.Dedup
+	if policy == nil {
+		return ""
+	}
+
+	key := policy.KeyOf(msg.Metadata, msg.Payload)
+	if key == "" {
+		return ""
+	}
+	return reg.Topic + ":" + handlerName(reg.Meta) + ":" + key
+}
+
+// handlerName은 핸들러를 "컨트롤러.메서드" 형태로 나타냅니다.
+func handlerName(meta core.HandlerMeta) string {
+	if meta.ControllerType == nil {
+		return meta.Method.Name
+	}
+	return meta.ControllerType.String() + "." + meta.Method.Name
+}
+
+// isDuplicate는 저장소 조회에 실패하면 메시지를 잃지 않도록 중복이 아닌 것으로 봅니다.
+func (r *Runtime) isDuplicate(ctx context.Context, reg Registration, key string) bool {
+	seen, err := reg.Options.Dedup.Store.Seen(context.WithoutCancel(ctx), key)
+	if err != nil {
+		log.Printf("[Event Consumer] Dedup lookup failed (%s, key=%s): %v", reg.Topic, key, err)
+		return false
+	}
+	return seen
+}
+
+func (r *Runtime) markProcessed(ctx context.Context, reg Registration, key string) {
+	policy := reg.Options.Dedup
+	if err := policy.Store.Mark(context.WithoutCancel(ctx), key, policy.Expiry()); err != nil {
+		log.Printf("[Event Consumer] Dedup record failed (%s, key=%s): %v", reg.Topic, key, err)
+	}
+}
+
+// requestDeadline은 요청 메시지에 실린 호출자의 마감 시각을 반환합니다.
+func requestDeadline(msg *Message) (time.Time, bool) {
+	if !msg.IsRequest() {
+		return time.Time{}, false
+	}
+
+	value := msg.Metadata.Header(eventpublish.RequestDeadlineHeader)
+	if value == "" {
+		return time.Time{}, false
+	}
+	deadline, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return deadline, true
+}
+
+// replySuccess는 핸들러가 남긴 응답을 보냅니다. 반환값 없이 끝난 요청에도 빈 응답을 보내 호출자가 기다리지 않게 합니다.
+func (r *Runtime) replySuccess(ctx context.Context, reg Registration, msg *Message, reqCtx core.ExecutionContext) {
+	if !msg.IsRequest() || msg.Replied() {
+		return
+	}
+
+	var reply publish.Reply
+	if c, ok := reqCtx.(*ConsumerRequestContextImpl); ok {
+		reply, _ = c.pendingReply()
+	}
+	if err := msg.Reply(ctx, reply); err != nil {
+		log.Printf("[Event Consumer] Reply failed (%s): %v", reg.Topic, err)
+	}
+}
+
+/*
+replyError는 처리하지 못한 요청에 실패 응답을 보내, 호출자가 마감 시각까지 기다리지 않게 합니다.
+내부 에러나 panic 스택이 호출자에게 새지 않도록, 응답에는 httperr.HTTPError의 메시지만 담고
+그 외에는 일반 메시지를 보냅니다. 전체 원인은 로그로 남깁니다.
+*/
+func (r *Runtime) replyError(ctx context.Context, reg Registration, msg *Message, cause error) {
+	if !msg.IsRequest() || msg.Replied() {
+		return
+	}
+	log.Printf("[Event Consumer] Replying with failure (%s): %v", reg.Topic, cause)
+
+	reply := publish.Reply{Headers: map[string]string{eventpublish.ReplyErrorHeader: replyErrorMessage(cause)}}
+	if err := msg.Reply(context.WithoutCancel(ctx), reply); err != nil {
+		log.Printf("[Event Consumer] Reply failed (%s): %v", reg.Topic, err)
+	}
+}
+
+// replyErrorMessage는 호출자에게 보여줄 수 있는 실패 메시지를 반환합니다.
+func replyErrorMessage(cause error) string {
+	var httpErr *httperr.HTTPError
+	if errors.As(cause, &httpErr) && httpErr.Message != "" {
+		return httpErr.Message
+	}
+	return defaultReplyErrorMessage
+}
+
+func (r *Runtime) nack(reg Registration, msg *Message) {
+	if nackErr := msg.Nack(); nackErr != nil {
+		log.Printf("[Event Consumer] NACK failed (%s): %v", reg.Topic, nackErr)
 	}
 }
 
@@ -139,11 +394,17 @@ func (r *Runtime) Validate() error {
 	return nil
 }
 
+// Stop은 메시지 읽기를 멈추고, 처리 중인 메시지가 모두 끝나 Reader가 닫힐 때까지 기다립니다.
 func (r *Runtime) Stop() {
 	r.stopOnce.Do(func() {
+		r.mu.Lock()
+		r.stopped = true
 		if r.cancel != nil {
 			r.cancel() // 모든 goroutine 중지
 		}
+		r.mu.Unlock()
+
+		r.wg.Wait()
 		close(r.done)
 		log.Printf("[Event Consumer] All consumers stopped")
 	})
@@ -2,15 +2,28 @@ package consumer
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/NARUBROWN/spine/core"
 	"github.com/NARUBROWN/spine/internal/container"
+	eventhandler "github.com/NARUBROWN/spine/internal/event/consumer/handler"
 	eventresolver "github.com/NARUBROWN/spine/internal/event/consumer/resolver"
+	"github.com/NARUBROWN/spine/internal/event/publish"
 	"github.com/NARUBROWN/spine/internal/invoker"
 	"github.com/NARUBROWN/spine/internal/pipeline"
+	"github.com/NARUBROWN/spine/internal/resolver"
+	"github.com/NARUBROWN/spine/pkg/event"
+	"github.com/NARUBROWN/spine/pkg/event/consume"
+	eventpublish "github.com/NARUBROWN/spine/pkg/event/publish"
+	"github.com/NARUBROWN/spine/pkg/httperr"
+	"github.com/NARUBROWN/spine/pkg/validate"
 )
 
 type runtimeTestRouter struct {
@@ -38,6 +51,26 @@ func (r *runtimeTestReader) Read(ctx context.Context) (*Message, error) {
 
 func (r *runtimeTestReader) Close() error { return nil }
 
+// runtimeTestQueueReader는 준비된 메시지를 차례로 반환한 뒤 종료될 때까지 대기합니다.
+type runtimeTestQueueReader struct {
+	msgs   chan *Message
+	closed atomic.Bool
+}
+
+func (r *runtimeTestQueueReader) Read(ctx context.Context) (*Message, error) {
+	select {
+	case msg := <-r.msgs:
+		return msg, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (r *runtimeTestQueueReader) Close() error {
+	r.closed.Store(true)
+	return nil
+}
+
 type runtimeTestFactory struct {
 	reader Reader
 }
@@ -62,6 +95,54 @@ func (c *runtimeTestController) Panic(payload []byte) {
 	panic("boom")
 }
 
+var (
+	runtimeTestStarted chan string
+	runtimeTestRelease chan struct{}
+)
+
+// Block은 시작을 알린 뒤 runtimeTestRelease가 닫힐 때까지 대기합니다.
+func (c *runtimeTestController) Block(payload []byte) {
+	runtimeTestStarted <- string(payload)
+	<-runtimeTestRelease
+}
+
+var (
+	errRuntimeTransient = errors.New("transient failure")
+	runtimeTestCalls    atomic.Int32
+	runtimeTestFailures atomic.Int32
+)
+
+// Fail은 runtimeTestFailures 횟수만큼 payload에 맞는 에러를 반환한 뒤 성공합니다.
+func (c *runtimeTestController) Fail(payload []byte) error {
+	runtimeTestCalls.Add(1)
+	if runtimeTestFailures.Add(-1) < 0 {
+		return nil
+	}
+
+	switch string(payload) {
+	case "invalid":
+		return validate.Errors{{Field: "id", Rule: "required", Message: "id is required"}}
+	case "permanent":
+		return consume.Permanent(errRuntimeTransient)
+	case "rejected":
+		return consume.Permanent(fmt.Errorf("handle: %w", httperr.BadRequest("sku is not available")))
+	default:
+		return fmt.Errorf("handle: %w", errRuntimeTransient)
+	}
+}
+
+type runtimeTestQuote struct {
+	SKU         string `json:"sku"`
+	HasDeadline bool   `json:"hasDeadline"`
+}
+
+// Quote는 payload를 SKU로 하는 견적을 반환합니다.
+func (c *runtimeTestController) Quote(ctx context.Context, payload []byte) runtimeTestQuote {
+	runtimeTestCalls.Add(1)
+	_, hasDeadline := ctx.Deadline()
+	return runtimeTestQuote{SKU: string(payload), HasDeadline: hasDeadline}
+}
+
 func newRuntimePipeline(t *testing.T, methodName string, hookErr error) *pipeline.Pipeline {
 	t.Helper()
 
@@ -88,7 +169,8 @@ func newRuntimePipeline(t *testing.T, methodName string, hookErr error) *pipelin
 		invoker.NewInvoker(ctr),
 	)
 
-	p.AddArgumentResolver(&eventresolver.PayloadResolver{})
+	p.AddArgumentResolver(&resolver.StdContextResolver{}, &eventresolver.PayloadResolver{})
+	p.AddReturnValueHandler(&eventhandler.ReplyReturnHandler{})
 	if hookErr != nil {
 		p.AddPostExecutionHook(&runtimeTestPostHook{err: hookErr})
 	}
@@ -175,3 +257,509 @@ func TestRuntime_NackOnRecoveredPanic(t *testing.T) {
 		t.Fatalf("panic 복구 시 NACK 되어야 합니다. 실제=%s", got)
 	}
 }
+
+// runRetryScenario는 Fail 핸들러를 정책과 함께 실행하고, 메시지 처리 결과 신호와 Dead Letter 정보를 반환합니다.
+func runRetryScenario(t *testing.T, payload string, failures int32, policy consume.RetryPolicy) (string, *DeadLetter) {
+	t.Helper()
+
+	runtimeTestCalls.Store(0)
+	runtimeTestFailures.Store(failures)
+
+	registry := NewRegistry()
+	if err := registry.Register("topic", (*runtimeTestController).Fail, consume.WithRetry(policy)); err != nil {
+		t.Fatalf("등록 실패: %v", err)
+	}
+
+	signals := make(chan string, 4)
+	var letter *DeadLetter
+	msg := &Message{
+		EventName: "topic",
+		Payload:   []byte(payload),
+	}
+	msg.SetAckHandler(func() error {
+		signals <- "ack"
+		return nil
+	})
+	msg.SetNackHandler(func() error {
+		signals <- "nack"
+		return nil
+	})
+	msg.SetRejectHandler(func() error {
+		signals <- "reject"
+		return nil
+	})
+	msg.SetDeadLetterHandler(func(ctx context.Context, l DeadLetter) error {
+		letter = &l
+		return nil
+	})
+
+	runtime := NewRuntime(
+		registry,
+		&runtimeTestFactory{reader: &runtimeTestReader{msg: msg}},
+		newRuntimePipeline(t, "Fail", nil),
+	)
+
+	runtime.Start(context.Background())
+	defer runtime.Stop()
+
+	return waitSignal(t, signals), letter
+}
+
+func TestRuntime_RetriesUntilSuccess(t *testing.T) {
+	got, letter := runRetryScenario(t, "transient", 2, consume.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		DeadLetter:     "topic.dlq",
+	})
+
+	if got != "ack" || letter != nil {
+		t.Fatalf("재시도 후 성공하면 ACK 되어야 합니다. 실제=%s, dead letter=%+v", got, letter)
+	}
+	if calls := runtimeTestCalls.Load(); calls != 3 {
+		t.Fatalf("핸들러 호출 횟수가 잘못되었습니다: %d", calls)
+	}
+}
+
+func TestRuntime_SendsToDeadLetterAfterAttemptsExhausted(t *testing.T) {
+	got, letter := runRetryScenario(t, "transient", 100, consume.RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		Jitter:         0.5,
+		DeadLetter:     "topic.dlq",
+	})
+
+	if got != "ack" {
+		t.Fatalf("Dead Letter 전송 후 원본은 ACK 되어야 합니다. 실제=%s", got)
+	}
+	if letter == nil || letter.Destination != "topic.dlq" || letter.Attempts != 3 {
+		t.Fatalf("Dead Letter 정보가 잘못되었습니다: %+v", letter)
+	}
+	if !errors.Is(letter.Err, errRuntimeTransient) {
+		t.Fatalf("핸들러 에러가 Dead Letter에 보존되어야 합니다: %v", letter.Err)
+	}
+}
+
+func TestRuntime_NonRetryableErrorsSkipRetries(t *testing.T) {
+	for _, payload := range []string{"invalid", "permanent"} {
+		got, letter := runRetryScenario(t, payload, 100, consume.RetryPolicy{
+			MaxAttempts:    5,
+			InitialBackoff: time.Millisecond,
+			DeadLetter:     "topic.dlq",
+		})
+
+		if got != "ack" || letter == nil || letter.Attempts != 1 {
+			t.Fatalf("%s: 재시도 없이 Dead Letter로 보내야 합니다. 실제=%s, dead letter=%+v", payload, got, letter)
+		}
+		if calls := runtimeTestCalls.Load(); calls != 1 {
+			t.Fatalf("%s: 핸들러 호출 횟수가 잘못되었습니다: %d", payload, calls)
+		}
+	}
+
+	got, letter := runRetryScenario(t, "transient", 100, consume.RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Millisecond,
+		NonRetryable:   []error{errRuntimeTransient},
+		DeadLetter:     "topic.dlq",
+	})
+	if got != "ack" || letter == nil || letter.Attempts != 1 {
+		t.Fatalf("NonRetryable 에러는 재시도하면 안 됩니다. 실제=%s, dead letter=%+v", got, letter)
+	}
+}
+
+func TestRuntime_RejectsWithoutDeadLetter(t *testing.T) {
+	got, letter := runRetryScenario(t, "transient", 100, consume.RetryPolicy{
+		MaxAttempts:    2,
+		InitialBackoff: time.Millisecond,
+	})
+
+	if got != "reject" || letter != nil {
+		t.Fatalf("Dead Letter가 없으면 requeue 없이 폐기해야 합니다. 실제=%s", got)
+	}
+	if calls := runtimeTestCalls.Load(); calls != 2 {
+		t.Fatalf("핸들러 호출 횟수가 잘못되었습니다: %d", calls)
+	}
+}
+
+func TestRetryPolicy_BackoffGrowsAndCaps(t *testing.T) {
+	policy := consume.RetryPolicy{
+		InitialBackoff: 10 * time.Millisecond,
+		MaxBackoff:     50 * time.Millisecond,
+		Multiplier:     3,
+	}
+
+	if got := policy.Backoff(1); got != 10*time.Millisecond {
+		t.Fatalf("첫 대기 시간이 잘못되었습니다: %s", got)
+	}
+	if got := policy.Backoff(2); got != 30*time.Millisecond {
+		t.Fatalf("지수 증가가 잘못되었습니다: %s", got)
+	}
+	if got := policy.Backoff(3); got != 50*time.Millisecond {
+		t.Fatalf("대기 시간 상한이 적용되어야 합니다: %s", got)
+	}
+
+	policy.Jitter = 0.5
+	for i := 0; i < 20; i++ {
+		if got := policy.Backoff(2); got < 15*time.Millisecond || got > 30*time.Millisecond {
+			t.Fatalf("지터 범위가 잘못되었습니다: %s", got)
+		}
+	}
+}
+
+func TestRuntime_ConcurrentWorkersAndDrainOnStop(t *testing.T) {
+	runtimeTestStarted = make(chan string, 2)
+	runtimeTestRelease = make(chan struct{})
+
+	registry := NewRegistry()
+	if err := registry.Register("topic", (*runtimeTestController).Block, consume.WithConcurrency(2)); err != nil {
+		t.Fatalf("등록 실패: %v", err)
+	}
+
+	acks := make(chan string, 2)
+	reader := &runtimeTestQueueReader{msgs: make(chan *Message, 2)}
+	for _, key := range []string{"a", "b"} {
+		msg := &Message{EventName: "topic", Payload: []byte(key)}
+		msg.SetOrderingKey(key)
+		msg.SetAckHandler(func() error {
+			acks <- key
+			return nil
+		})
+		reader.msgs <- msg
+	}
+
+	runtime := NewRuntime(
+		registry,
+		&runtimeTestFactory{reader: reader},
+		newRuntimePipeline(t, "Block", nil),
+	)
+	runtime.Start(context.Background())
+
+	// 두 메시지가 동시에 처리 중이어야 한다.
+	waitSignal(t, runtimeTestStarted)
+	waitSignal(t, runtimeTestStarted)
+
+	stopped := make(chan struct{})
+	go func() {
+		runtime.Stop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		t.Fatal("처리 중인 메시지가 끝나기 전에 Stop이 반환되면 안 됩니다")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if reader.closed.Load() {
+		t.Fatal("처리 중인 메시지가 끝나기 전에 Reader가 닫히면 안 됩니다")
+	}
+
+	close(runtimeTestRelease)
+	waitSignal(t, acks)
+	waitSignal(t, acks)
+
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("처리 완료 후 Stop이 반환되어야 합니다")
+	}
+	if !reader.closed.Load() {
+		t.Fatal("Stop 이후 Reader가 닫혀야 합니다")
+	}
+}
+
+func TestWorkerPool_SameKeyIsProcessedInOrder(t *testing.T) {
+	var mu sync.Mutex
+	var order []string
+	pool := newWorkerPool(4, func(msg *Message) {
+		time.Sleep(time.Millisecond)
+		mu.Lock()
+		order = append(order, string(msg.Payload))
+		mu.Unlock()
+	})
+
+	for i := 0; i < 5; i++ {
+		msg := &Message{Payload: []byte(fmt.Sprint(i))}
+		msg.SetOrderingKey("same")
+		if !pool.submit(context.Background(), msg) {
+			t.Fatal("submit이 실패하면 안 됩니다")
+		}
+	}
+	pool.drain()
+
+	if got := fmt.Sprint(order); got != "[0 1 2 3 4]" {
+		t.Fatalf("같은 키의 메시지는 순서대로 처리되어야 합니다: %s", got)
+	}
+}
+
+func TestRequestContext_HeaderReadsMessageHeaders(t *testing.T) {
+	msg := &Message{
+		EventName: "topic",
+		Metadata:  event.Metadata{Headers: map[string]string{"Authorization": "Bearer t"}},
+	}
+	ctx := NewRequestContext(context.Background(), msg, nil)
+
+	if got := ctx.Header("authorization"); got != "Bearer t" {
+		t.Fatalf("메시지 헤더를 반환해야 합니다: %q", got)
+	}
+	carrier, ok := ctx.(core.MetadataCarrier)
+	if !ok || carrier.Metadata().Headers["Authorization"] != "Bearer t" {
+		t.Fatal("Consumer context는 metadata를 제공해야 합니다")
+	}
+}
+
+func TestRuntime_DedupSkipsProcessedMessages(t *testing.T) {
+	runtimeTestCalls.Store(0)
+	runtimeTestFailures.Store(1)
+
+	store := consume.NewMemoryDedupStore(10)
+	registry := NewRegistry()
+	if err := registry.Register("topic", (*runtimeTestController).Fail, consume.WithDedup(consume.DedupPolicy{Store: store})); err != nil {
+		t.Fatalf("등록 실패: %v", err)
+	}
+
+	signals := make(chan string, 3)
+	reader := &runtimeTestQueueReader{msgs: make(chan *Message, 3)}
+	for range 3 {
+		msg := &Message{
+			EventName: "topic",
+			Payload:   []byte("transient"),
+			Metadata:  event.Metadata{MessageID: "msg-1"},
+		}
+		msg.SetAckHandler(func() error {
+			signals <- "ack"
+			return nil
+		})
+		msg.SetNackHandler(func() error {
+			signals <- "nack"
+			return nil
+		})
+		reader.msgs <- msg
+	}
+
+	runtime := NewRuntime(
+		registry,
+		&runtimeTestFactory{reader: reader},
+		newRuntimePipeline(t, "Fail", nil),
+	)
+	runtime.Start(context.Background())
+	defer runtime.Stop()
+
+	// 실패한 첫 메시지는 기록되지 않으므로 두 번째 메시지는 다시 처리되고, 세 번째는 건너뛴다.
+	for _, want := range []string{"nack", "ack", "ack"} {
+		if got := waitSignal(t, signals); got != want {
+			t.Fatalf("예상=%s, 실제=%s", want, got)
+		}
+	}
+	if calls := runtimeTestCalls.Load(); calls != 2 {
+		t.Fatalf("중복 메시지는 핸들러를 실행하지 않아야 합니다. 호출 횟수=%d", calls)
+	}
+	if seen, _ := store.Seen(context.Background(), "topic:*consumer.runtimeTestController.Fail:msg-1"); !seen {
+		t.Fatal("성공한 메시지의 키가 기록되어야 합니다")
+	}
+}
+
+func TestRuntime_DedupKeySeparatesHandlersOnSameTopic(t *testing.T) {
+	store := consume.NewMemoryDedupStore(10)
+	registry := NewRegistry()
+	for _, target := range []any{(*runtimeTestController).Handle, (*runtimeTestController).Fail} {
+		if err := registry.Register("topic", target, consume.WithDedup(consume.DedupPolicy{Store: store})); err != nil {
+			t.Fatalf("등록 실패: %v", err)
+		}
+	}
+
+	runtime := NewRuntime(registry, &runtimeTestFactory{}, newRuntimePipeline(t, "Handle", nil))
+	msg := &Message{EventName: "topic", Metadata: event.Metadata{MessageID: "msg-1"}}
+	regs := registry.Registrations()
+
+	first := runtime.dedupKey(regs[0], msg)
+	second := runtime.dedupKey(regs[1], msg)
+	if first == second {
+		t.Fatalf("같은 토픽이라도 핸들러마다 키가 달라야 합니다. 키=%s", first)
+	}
+
+	if err := store.Mark(context.Background(), first, time.Hour); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if runtime.isDuplicate(context.Background(), regs[1], second) {
+		t.Fatal("다른 핸들러가 처리한 메시지를 중복으로 보면 안 됩니다")
+	}
+}
+
+func TestRegistry_DedupRequiresStore(t *testing.T) {
+	registry := NewRegistry()
+	err := registry.Register("topic", (*runtimeTestController).Handle, consume.WithDedup(consume.DedupPolicy{}))
+	if err == nil {
+		t.Fatal("Dedup 저장소가 없으면 에러가 발생해야 합니다")
+	}
+}
+
+// newRequestMessage는 응답을 replies로 받는 요청 메시지를 만듭니다.
+func newRequestMessage(payload string, deadline time.Time, signals chan<- string, replies chan<- publish.Reply) *Message {
+	msg := &Message{
+		EventName: "topic",
+		Payload:   []byte(payload),
+		Metadata: event.Metadata{Headers: map[string]string{
+			eventpublish.RequestDeadlineHeader: deadline.UTC().Format(time.RFC3339Nano),
+		}},
+	}
+	msg.SetAckHandler(func() error {
+		signals <- "ack"
+		return nil
+	})
+	msg.SetNackHandler(func() error {
+		signals <- "nack"
+		return nil
+	})
+	msg.SetRejectHandler(func() error {
+		signals <- "reject"
+		return nil
+	})
+	msg.SetReplyHandler(func(ctx context.Context, reply publish.Reply) error {
+		replies <- reply
+		return nil
+	})
+	return msg
+}
+
+func runRequestScenario(t *testing.T, methodName string, msg *Message, opts ...consume.Option) {
+	t.Helper()
+
+	registry := NewRegistry()
+	controllerType := reflect.TypeOf(&runtimeTestController{})
+	method, _ := controllerType.MethodByName(methodName)
+	if err := registry.Register("topic", method.Func.Interface(), opts...); err != nil {
+		t.Fatalf("등록 실패: %v", err)
+	}
+
+	runtime := NewRuntime(
+		registry,
+		&runtimeTestFactory{reader: &runtimeTestReader{msg: msg}},
+		newRuntimePipeline(t, methodName, nil),
+	)
+	runtime.Start(context.Background())
+	t.Cleanup(runtime.Stop)
+}
+
+func TestRuntime_RepliesWithHandlerReturnValue(t *testing.T) {
+	signals := make(chan string, 2)
+	replies := make(chan publish.Reply, 2)
+	runRequestScenario(t, "Quote", newRequestMessage("sku-1", time.Now().Add(time.Minute), signals, replies))
+
+	if got := waitSignal(t, signals); got != "ack" {
+		t.Fatalf("요청 처리에 성공하면 ACK 되어야 합니다. 실제=%s", got)
+	}
+
+	reply := <-replies
+	if string(reply.Body) != `{"sku":"sku-1","hasDeadline":true}` {
+		t.Fatalf("반환값이 JSON 응답으로 전달되어야 하고, 마감 시각이 핸들러 context에 적용되어야 합니다: %s", reply.Body)
+	}
+	if reply.Headers["content-type"] != "application/json" {
+		t.Fatalf("응답의 content-type이 잘못되었습니다: %v", reply.Headers)
+	}
+	if len(replies) != 0 {
+		t.Fatal("요청 하나에는 한 번만 응답해야 합니다")
+	}
+}
+
+func TestRuntime_RepliesEmptyWhenHandlerReturnsNothing(t *testing.T) {
+	signals := make(chan string, 2)
+	replies := make(chan publish.Reply, 2)
+	runRequestScenario(t, "Handle", newRequestMessage("hello", time.Now().Add(time.Minute), signals, replies))
+
+	if got := waitSignal(t, signals); got != "ack" {
+		t.Fatalf("요청 처리에 성공하면 ACK 되어야 합니다. 실제=%s", got)
+	}
+	if reply := <-replies; len(reply.Body) != 0 || reply.Headers[eventpublish.ReplyErrorHeader] != "" {
+		t.Fatalf("반환값이 없으면 빈 응답을 보내야 합니다: %+v", reply)
+	}
+}
+
+func TestRuntime_DoesNotReplyWhenPostExecutionFails(t *testing.T) {
+	signals := make(chan string, 2)
+	replies := make(chan publish.Reply, 2)
+	msg := newRequestMessage("sku-1", time.Now().Add(time.Minute), signals, replies)
+
+	registry := NewRegistry()
+	if err := registry.Register("topic", (*runtimeTestController).Quote); err != nil {
+		t.Fatalf("등록 실패: %v", err)
+	}
+	runtime := NewRuntime(
+		registry,
+		&runtimeTestFactory{reader: &runtimeTestReader{msg: msg}},
+		newRuntimePipeline(t, "Quote", errors.New("hook failed")),
+	)
+	runtime.Start(context.Background())
+	defer runtime.Stop()
+
+	if got := waitSignal(t, signals); got != "nack" {
+		t.Fatalf("후속 처리가 실패하면 NACK 되어야 합니다. 실제=%s", got)
+	}
+	if len(replies) != 0 {
+		t.Fatalf("후속 처리가 실패하면 성공 응답을 보내면 안 됩니다: %+v", <-replies)
+	}
+}
+
+func TestRuntime_SkipsExpiredRequest(t *testing.T) {
+	runtimeTestCalls.Store(0)
+	signals := make(chan string, 2)
+	replies := make(chan publish.Reply, 2)
+	runRequestScenario(t, "Quote", newRequestMessage("sku-1", time.Now().Add(-time.Second), signals, replies))
+
+	if got := waitSignal(t, signals); got != "ack" {
+		t.Fatalf("마감 시각이 지난 요청은 ACK 후 건너뛰어야 합니다. 실제=%s", got)
+	}
+	if calls := runtimeTestCalls.Load(); calls != 0 || len(replies) != 0 {
+		t.Fatalf("마감 시각이 지난 요청은 처리하지 않아야 합니다. 호출 횟수=%d, 응답=%d", calls, len(replies))
+	}
+}
+
+func TestRuntime_RepliesWithErrorAfterGivingUp(t *testing.T) {
+	runtimeTestFailures.Store(1)
+	signals := make(chan string, 2)
+	replies := make(chan publish.Reply, 2)
+	runRequestScenario(t, "Fail", newRequestMessage("permanent", time.Now().Add(time.Minute), signals, replies),
+		consume.WithRetry(consume.RetryPolicy{MaxAttempts: 3}),
+	)
+
+	if got := waitSignal(t, signals); got != "reject" {
+		t.Fatalf("재시도하지 않을 요청은 폐기되어야 합니다. 실제=%s", got)
+	}
+	reply := <-replies
+	if message := reply.Headers[eventpublish.ReplyErrorHeader]; message == "" || strings.Contains(message, errRuntimeTransient.Error()) {
+		t.Fatalf("실패 응답에는 내부 에러 대신 일반 메시지가 담겨야 합니다: %+v", reply)
+	}
+}
+
+func TestRuntime_RepliesWithHTTPErrorMessage(t *testing.T) {
+	runtimeTestFailures.Store(1)
+	signals := make(chan string, 2)
+	replies := make(chan publish.Reply, 2)
+	runRequestScenario(t, "Fail", newRequestMessage("rejected", time.Now().Add(time.Minute), signals, replies),
+		consume.WithRetry(consume.RetryPolicy{MaxAttempts: 3}),
+	)
+
+	if got := waitSignal(t, signals); got != "reject" {
+		t.Fatalf("재시도하지 않을 요청은 폐기되어야 합니다. 실제=%s", got)
+	}
+	reply := <-replies
+	if message := reply.Headers[eventpublish.ReplyErrorHeader]; message != "sku is not available" {
+		t.Fatalf("HTTPError의 메시지만 응답에 담겨야 합니다: %+v", reply)
+	}
+}
+
+func TestRuntime_IgnoresReturnValueOfNonRequest(t *testing.T) {
+	signals := make(chan string, 2)
+	msg := &Message{EventName: "topic", Payload: []byte("sku-1")}
+	msg.SetAckHandler(func() error {
+		signals <- "ack"
+		return nil
+	})
+	msg.SetNackHandler(func() error {
+		signals <- "nack"
+		return nil
+	})
+	runRequestScenario(t, "Quote", msg)
+
+	if got := waitSignal(t, signals); got != "ack" {
+		t.Fatalf("요청이 아닌 메시지의 반환값은 무시하고 ACK 되어야 합니다. 실제=%s", got)
+	}
+}
@@ -0,0 +1,80 @@
+package consumer
+
+import (
+	"context"
+	"hash/fnv"
+	"sync"
+)
+
+/*
+workerPool은 하나의 Reader가 읽은 메시지를 여러 worker에 나눠 처리합니다.
+ordering key가 있는 메시지는 키 해시로 정해진 worker가 순서대로 처리하고,
+키가 없는 메시지는 비어 있는 아무 worker가 가져갑니다.
+*/
+type workerPool struct {
+	shared chan *Message
+	keyed  []chan *Message
+	wg     sync.WaitGroup
+}
+
+func newWorkerPool(size int, handle func(msg *Message)) *workerPool {
+	p := &workerPool{
+		shared: make(chan *Message),
+		keyed:  make([]chan *Message, size),
+	}
+
+	for i := range p.keyed {
+		p.keyed[i] = make(chan *Message)
+		p.wg.Add(1)
+		go p.work(p.keyed[i], handle)
+	}
+	return p
+}
+
+func (p *workerPool) work(own chan *Message, handle func(msg *Message)) {
+	defer p.wg.Done()
+
+	shared := p.shared
+	for own != nil || shared != nil {
+		select {
+		case msg, ok := <-own:
+			if !ok {
+				own = nil
+				continue
+			}
+			handle(msg)
+		case msg, ok := <-shared:
+			if !ok {
+				shared = nil
+				continue
+			}
+			handle(msg)
+		}
+	}
+}
+
+// submit은 worker가 메시지를 받을 때까지 기다립니다. ctx가 먼저 끝나면 false를 반환합니다.
+func (p *workerPool) submit(ctx context.Context, msg *Message) bool {
+	target := p.shared
+	if key := msg.OrderingKey(); key != "" {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(key))
+		target = p.keyed[h.Sum32()%uint32(len(p.keyed))]
+	}
+
+	select {
+	case target <- msg:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// drain은 더 이상 메시지를 받지 않고, 처리 중인 메시지가 모두 끝날 때까지 기다립니다.
+func (p *workerPool) drain() {
+	close(p.shared)
+	for _, ch := range p.keyed {
+		close(ch)
+	}
+	p.wg.Wait()
+}
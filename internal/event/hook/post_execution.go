@@ -1,8 +1,18 @@
 package hook
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+
 	"github.com/NARUBROWN/spine/core"
-	"github.com/NARUBROWN/spine/internal/event/publish"
+	internalpublish "github.com/NARUBROWN/spine/internal/event/publish"
+	"github.com/NARUBROWN/spine/pkg/event/codec"
+	"github.com/NARUBROWN/spine/pkg/event/outbox"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
 )
 
 type PostExecutionHook interface {
@@ -10,7 +20,7 @@ type PostExecutionHook interface {
 }
 
 type EventDispatchHook struct {
-	Dispatcher publish.EventDispatcher
+	Dispatcher internalpublish.EventDispatcher
 }
 
 func (h *EventDispatchHook) AfterExecution(ctx core.ExecutionContext, results []any, err error) error {
@@ -23,5 +33,89 @@ func (h *EventDispatchHook) AfterExecution(ctx core.ExecutionContext, results []
 		return nil
 	}
 
-	return h.Dispatcher.Dispatch(ctx.Context(), events)
+	return h.Dispatcher.Dispatch(withPropagatedHeaders(ctx), events)
+}
+
+/*
+OutboxHook은 Outbox 모드에서 EventDispatchHook 대신 사용됩니다.
+요청 처리 중 발행된 이벤트를 브로커로 보내지 않고 Store에 기록하며, 실제 발행은 relay가 담당합니다.
+
+ScopedTx면 실행 스코프의 *outbox.Tx 안에서 기록합니다. Tx는 스코프가 닫힐 때 실행 결과에 따라
+Commit / Rollback 되므로, 같은 Tx로 기록한 도메인 변경과 이벤트가 함께 커밋됩니다.
+*/
+type OutboxHook struct {
+	Store    outbox.Store
+	ScopedTx bool
+	// 레코드 본문을 바로 발행할 때와 같은 Codec으로 기록한다.
+	Codecs *codec.Registry
+}
+
+func (h *OutboxHook) AfterExecution(ctx core.ExecutionContext, results []any, err error) error {
+	if err != nil {
+		return nil
+	}
+
+	events := ctx.EventBus().Drain()
+	if len(events) == 0 {
+		return nil
+	}
+
+	publishCtx := outbox.WithCodecs(withPropagatedHeaders(ctx), h.Codecs)
+	if _, ok := outbox.TxFromContext(publishCtx); !ok && h.ScopedTx {
+		tx, err := scopedOutboxTx(ctx)
+		if err != nil {
+			return err
+		}
+		publishCtx = outbox.WithTx(publishCtx, tx)
+	}
+	return outbox.Append(publishCtx, h.Store, events...)
+}
+
+// resolver는 실행 스코프에서 컴포넌트를 꺼냅니다. (container.Scope)
+type resolver interface {
+	Resolve(componentType reflect.Type) (any, error)
+}
+
+// scopedOutboxTx는 Pipeline이 ctx에 둔 실행 스코프에서 *outbox.Tx를 꺼냅니다.
+func scopedOutboxTx(ctx core.ExecutionContext) (*outbox.Tx, error) {
+	raw, _ := ctx.Get("spine.scope")
+	scope, ok := raw.(resolver)
+	if !ok {
+		return nil, errors.New("outbox: execution scope is not available")
+	}
+	tx, err := scope.Resolve(reflect.TypeFor[*outbox.Tx]())
+	if err != nil {
+		return nil, fmt.Errorf("outbox: failed to resolve transaction: %w", err)
+	}
+	return tx.(*outbox.Tx), nil
+}
+
+/*
+withPropagatedHeaders는 원본 요청(HTTP 헤더, 메시지 헤더)의 correlation ID와 trace 헤더를
+발행 context에 실어, 이벤트 체인 전체가 같은 ID로 추적되도록 합니다.
+correlation ID가 없으면 새로 만듭니다.
+*/
+func withPropagatedHeaders(ctx core.ExecutionContext) context.Context {
+	headers := make(map[string]string, 2)
+
+	correlationID := ctx.Header(publish.CorrelationIDHeader)
+	if correlationID == "" {
+		correlationID = ctx.Header("X-Request-Id")
+	}
+	if correlationID == "" {
+		correlationID = newCorrelationID()
+	}
+	headers[publish.CorrelationIDHeader] = correlationID
+
+	if traceParent := ctx.Header(publish.TraceParentHeader); traceParent != "" {
+		headers[publish.TraceParentHeader] = traceParent
+	}
+
+	return publish.ContextWithHeaders(ctx.Context(), headers)
+}
+
+func newCorrelationID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
 }
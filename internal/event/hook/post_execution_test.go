@@ -3,11 +3,14 @@ package hook
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/NARUBROWN/spine/core"
 	internalpublish "github.com/NARUBROWN/spine/internal/event/publish"
+	"github.com/NARUBROWN/spine/pkg/event/outbox"
 	pkgevent "github.com/NARUBROWN/spine/pkg/event/publish"
 )
 
@@ -36,19 +39,23 @@ func (b *testEventBus) Drain() []pkgevent.DomainEvent {
 }
 
 type testDispatcher struct {
+	ctx    context.Context
 	called int
 	got    []pkgevent.DomainEvent
 	err    error
 }
 
 func (d *testDispatcher) Dispatch(ctx context.Context, events []pkgevent.DomainEvent) error {
+	d.ctx = ctx
 	d.called++
 	d.got = append([]pkgevent.DomainEvent(nil), events...)
 	return d.err
 }
 
 type testExecutionContext struct {
-	bus core.EventBus
+	bus     core.EventBus
+	headers map[string]string
+	values  map[string]any
 }
 
 func (c *testExecutionContext) Context() context.Context     { return context.Background() }
@@ -56,11 +63,14 @@ func (c *testExecutionContext) EventBus() core.EventBus      { return c.bus }
 func (c *testExecutionContext) Method() string               { return "GET" }
 func (c *testExecutionContext) Path() string                 { return "/" }
 func (c *testExecutionContext) Params() map[string]string    { return map[string]string{} }
-func (c *testExecutionContext) Header(name string) string    { return "" }
+func (c *testExecutionContext) Header(name string) string    { return c.headers[name] }
 func (c *testExecutionContext) PathKeys() []string           { return nil }
 func (c *testExecutionContext) Queries() map[string][]string { return map[string][]string{} }
 func (c *testExecutionContext) Set(key string, value any)    {}
-func (c *testExecutionContext) Get(key string) (any, bool)   { return nil, false }
+func (c *testExecutionContext) Get(key string) (any, bool) {
+	v, ok := c.values[key]
+	return v, ok
+}
 
 var _ internalpublish.EventDispatcher = (*testDispatcher)(nil)
 
@@ -126,3 +136,126 @@ func TestEventDispatchHook_NoEventsIsNoop(t *testing.T) {
 		t.Fatal("이벤트가 없으면 dispatch 하면 안 됩니다")
 	}
 }
+
+func TestEventDispatchHook_PropagatesCorrelationHeaders(t *testing.T) {
+	bus := &testEventBus{}
+	bus.Publish(testDomainEvent{name: "order.created", at: time.Now()})
+	dispatcher := &testDispatcher{}
+
+	ctx := &testExecutionContext{bus: bus, headers: map[string]string{
+		"X-Correlation-Id": "corr-1",
+		"traceparent":      "00-abc-def-01",
+	}}
+	if err := (&EventDispatchHook{Dispatcher: dispatcher}).AfterExecution(ctx, nil, nil); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	headers := pkgevent.HeadersFromContext(dispatcher.ctx)
+	if headers[pkgevent.CorrelationIDHeader] != "corr-1" || headers[pkgevent.TraceParentHeader] != "00-abc-def-01" {
+		t.Fatalf("원본 요청의 헤더가 전파되어야 합니다: %v", headers)
+	}
+}
+
+func TestEventDispatchHook_GeneratesCorrelationID(t *testing.T) {
+	bus := &testEventBus{}
+	bus.Publish(testDomainEvent{name: "order.created", at: time.Now()})
+	dispatcher := &testDispatcher{}
+
+	ctx := &testExecutionContext{bus: bus}
+	if err := (&EventDispatchHook{Dispatcher: dispatcher}).AfterExecution(ctx, nil, nil); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	headers := pkgevent.HeadersFromContext(dispatcher.ctx)
+	if len(headers[pkgevent.CorrelationIDHeader]) != 32 {
+		t.Fatalf("correlation ID가 없으면 새로 만들어야 합니다: %v", headers)
+	}
+	if _, ok := headers[pkgevent.TraceParentHeader]; ok {
+		t.Fatalf("없는 trace 헤더를 만들면 안 됩니다: %v", headers)
+	}
+}
+
+func TestOutboxHook_SavesEventsInsteadOfDispatching(t *testing.T) {
+	bus := &testEventBus{}
+	bus.Publish(testDomainEvent{name: "order.created", at: time.Now()})
+	store := outbox.NewMemoryStore()
+
+	ctx := &testExecutionContext{bus: bus, headers: map[string]string{"X-Correlation-Id": "corr-1"}}
+	if err := (&OutboxHook{Store: store}).AfterExecution(ctx, nil, nil); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	records := store.Records()
+	if len(records) != 1 || records[0].EventName != "order.created" {
+		t.Fatalf("이벤트는 Outbox에 기록되어야 합니다: %v", records)
+	}
+	if records[0].Headers[pkgevent.CorrelationIDHeader] != "corr-1" {
+		t.Fatalf("전파 헤더가 함께 기록되어야 합니다: %v", records[0].Headers)
+	}
+}
+
+func TestOutboxHook_IgnoresPriorError(t *testing.T) {
+	bus := &testEventBus{}
+	bus.Publish(testDomainEvent{name: "order.created", at: time.Now()})
+	store := outbox.NewMemoryStore()
+
+	err := (&OutboxHook{Store: store}).AfterExecution(&testExecutionContext{bus: bus}, nil, errors.New("controller failed"))
+	if err != nil {
+		t.Fatalf("기존 에러가 있으면 hook은 no-op 이어야 합니다: %v", err)
+	}
+	if len(store.Records()) != 0 {
+		t.Fatal("기존 에러가 있으면 Outbox에 기록하면 안 됩니다")
+	}
+}
+
+// txRecordingStore는 Save에 전달된 트랜잭션을 기록합니다.
+type txRecordingStore struct {
+	*outbox.MemoryStore
+	tx outbox.Executor
+}
+
+func (s *txRecordingStore) Save(ctx context.Context, records ...outbox.Record) error {
+	s.tx, _ = outbox.TxFromContext(ctx)
+	return s.MemoryStore.Save(ctx, records...)
+}
+
+// testScope는 실행 스코프에서 *outbox.Tx를 꺼내 줍니다.
+type testScope struct {
+	tx *outbox.Tx
+}
+
+func (s *testScope) Resolve(componentType reflect.Type) (any, error) {
+	if componentType != reflect.TypeFor[*outbox.Tx]() {
+		return nil, fmt.Errorf("unexpected type: %v", componentType)
+	}
+	return s.tx, nil
+}
+
+func TestOutboxHook_SavesInScopedTx(t *testing.T) {
+	bus := &testEventBus{}
+	bus.Publish(testDomainEvent{name: "order.created", at: time.Now()})
+	store := &txRecordingStore{MemoryStore: outbox.NewMemoryStore()}
+	tx := outbox.NewTx(context.Background(), nil)
+
+	ctx := &testExecutionContext{bus: bus, values: map[string]any{"spine.scope": &testScope{tx: tx}}}
+	if err := (&OutboxHook{Store: store, ScopedTx: true}).AfterExecution(ctx, nil, nil); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if store.tx != tx {
+		t.Fatalf("이벤트는 실행 스코프의 Tx 안에서 기록되어야 합니다: %v", store.tx)
+	}
+}
+
+func TestOutboxHook_ScopedTxRequiresScope(t *testing.T) {
+	bus := &testEventBus{}
+	bus.Publish(testDomainEvent{name: "order.created", at: time.Now()})
+	store := outbox.NewMemoryStore()
+
+	err := (&OutboxHook{Store: store, ScopedTx: true}).AfterExecution(&testExecutionContext{bus: bus}, nil, nil)
+	if err == nil {
+		t.Fatal("실행 스코프가 없으면 트랜잭션 밖에서 기록하지 않고 에러여야 합니다")
+	}
+	if len(store.Records()) != 0 {
+		t.Fatal("트랜잭션 없이 기록하면 안 됩니다")
+	}
+}
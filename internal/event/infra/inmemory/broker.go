@@ -0,0 +1,285 @@
+package inmemory
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/event/codec"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+const defaultGroupID = "spine"
+
+var errBrokerClosed = errors.New("in-memory broker is closed")
+
+/*
+Broker는 프로세스 내부에서 동작하는 이벤트 브로커입니다.
+
+  - 토픽에 발행된 메시지는 그 토픽을 구독한 모든 Consumer Group에 한 번씩 전달됩니다. (fan-out)
+  - 같은 그룹의 Reader끼리는 메시지를 나눠 받습니다.
+  - NACK된 메시지는 RedeliveryDelay 후 같은 그룹에 다시 전달됩니다.
+  - 구독한 그룹이 없는 토픽의 메시지는 버려집니다.
+*/
+type Broker struct {
+	mu     sync.Mutex
+	topics map[string]map[string]*groupQueue
+	offset map[string]int64
+	closed bool
+
+	deliveryDelay   time.Duration
+	redeliveryDelay time.Duration
+	// 이벤트 직렬화 형식 (nil이면 JSON)
+	codecs *codec.Registry
+}
+
+// envelope는 브로커 안에서 전달되는 메시지 한 건입니다.
+type envelope struct {
+	topic       string
+	key         string
+	payload     []byte
+	headers     map[string]string
+	offset      int64
+	messageID   string
+	timestamp   time.Time
+	redelivered bool
+}
+
+func NewBroker(opts boot.InMemoryOptions) *Broker {
+	return &Broker{
+		topics:          make(map[string]map[string]*groupQueue),
+		offset:          make(map[string]int64),
+		deliveryDelay:   opts.DeliveryDelay,
+		redeliveryDelay: opts.RedeliveryDelay,
+	}
+}
+
+// Publish는 이벤트를 직렬화해 이벤트 이름과 같은 토픽으로 발행합니다.
+func (b *Broker) Publish(ctx context.Context, event publish.DomainEvent) error {
+	msg, err := b.encode(ctx, event)
+	if err != nil {
+		return err
+	}
+	return b.send(msg, 0)
+}
+
+/*
+PublishAt은 deliverAt 이후에 구독 그룹에 전달되도록 이벤트를 발행합니다.
+대기 중인 메시지는 프로세스 메모리에만 있으므로 재시작하면 사라집니다. (유지가 필요하면 Outbox를 사용)
+*/
+func (b *Broker) PublishAt(ctx context.Context, event publish.DomainEvent, deliverAt time.Time) error {
+	msg, err := b.encode(ctx, event)
+	if err != nil {
+		return err
+	}
+	return b.send(msg, time.Until(deliverAt))
+}
+
+func (b *Broker) encode(ctx context.Context, event publish.DomainEvent) (envelope, error) {
+	// Codec(ce-id)과 X-Message-Id 헤더가 같은 ID를 쓰도록 ID를 한 번만 정한다.
+	ctx = publish.ContextWithMessageID(ctx, publish.MessageID(ctx, event))
+	encoded, err := b.codecs.Encode(ctx, event)
+	if err != nil {
+		return envelope{}, fmt.Errorf("in-memory publisher serialization failed: %w", err)
+	}
+
+	// Codec 헤더(content-type, ce-*)는 형식을 결정하므로 이벤트 헤더보다 우선한다.
+	headers := publish.MessageHeaders(ctx, event)
+	if headers == nil {
+		headers = make(map[string]string, len(encoded.Headers))
+	}
+	maps.Copy(headers, encoded.Headers)
+
+	return envelope{
+		topic:     event.Name(),
+		key:       publish.MessageKey(event),
+		payload:   encoded.Body,
+		headers:   headers,
+		timestamp: event.OccurredAt(),
+	}, nil
+}
+
+// SetCodecs는 이벤트를 직렬화할 Codec Registry를 지정합니다.
+func (b *Broker) SetCodecs(codecs *codec.Registry) {
+	b.codecs = codecs
+}
+
+// send는 토픽을 구독한 모든 그룹에 delay만큼 늦춰 메시지를 넣습니다. (DeliveryDelay는 항상 더해짐)
+func (b *Broker) send(msg envelope, delay time.Duration) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return errBrokerClosed
+	}
+
+	msg.offset = b.offset[msg.topic]
+	b.offset[msg.topic]++
+	msg.messageID = msg.headers[publish.MessageIDHeader]
+	if msg.messageID == "" {
+		msg.messageID = newMessageID()
+	}
+	if msg.timestamp.IsZero() {
+		msg.timestamp = time.Now()
+	}
+
+	for _, queue := range b.topics[msg.topic] {
+		b.deliver(queue, msg, b.deliveryDelay+max(delay, 0))
+	}
+	return nil
+}
+
+// deliver는 delay 후 그룹 큐에 메시지를 넣습니다. b.mu를 잡은 상태에서 호출합니다.
+func (b *Broker) deliver(queue *groupQueue, msg envelope, delay time.Duration) {
+	msg.headers = maps.Clone(msg.headers)
+	if delay <= 0 {
+		queue.push(msg)
+		return
+	}
+
+	// 브로커가 먼저 닫히면 닫힌 큐에 넣는 것은 무시된다.
+	time.AfterFunc(delay, func() {
+		queue.push(msg)
+	})
+}
+
+// redeliver는 NACK된 메시지를 원래 그룹에 다시 넣습니다.
+func (b *Broker) redeliver(queue *groupQueue, msg envelope) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return
+	}
+	msg.redelivered = true
+	b.deliver(queue, msg, b.redeliveryDelay)
+}
+
+// Subscribe는 토픽을 group으로 구독합니다. 이후 발행된 메시지는 Reader가 열리기 전에도 그룹 큐에 쌓입니다.
+func (b *Broker) Subscribe(topic, group string) error {
+	_, err := b.subscribe(topic, group)
+	return err
+}
+
+// subscribe는 토픽의 그룹 큐를 반환하며, 없으면 만듭니다. 그룹은 Reader가 닫혀도 유지됩니다.
+func (b *Broker) subscribe(topic, group string) (*groupQueue, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return nil, errBrokerClosed
+	}
+	if group == "" {
+		group = defaultGroupID
+	}
+
+	groups, ok := b.topics[topic]
+	if !ok {
+		groups = make(map[string]*groupQueue)
+		b.topics[topic] = groups
+	}
+	queue, ok := groups[group]
+	if !ok {
+		queue = newGroupQueue()
+		groups[group] = queue
+	}
+	return queue, nil
+}
+
+// Close는 더 이상 메시지를 받지 않고, 대기 중인 Reader를 깨웁니다.
+func (b *Broker) Close() error {
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil
+	}
+	b.closed = true
+	queues := make([]*groupQueue, 0)
+	for _, groups := range b.topics {
+		for _, queue := range groups {
+			queues = append(queues, queue)
+		}
+	}
+	b.mu.Unlock()
+
+	for _, queue := range queues {
+		queue.close()
+	}
+	return nil
+}
+
+func newMessageID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// groupQueue는 Consumer Group 하나가 받을 메시지 큐입니다.
+type groupQueue struct {
+	mu       sync.Mutex
+	messages []envelope
+	// 메시지가 들어오거나 큐가 닫히면 close되어 대기 중인 Reader를 모두 깨운다.
+	ready  chan struct{}
+	closed bool
+}
+
+func newGroupQueue() *groupQueue {
+	return &groupQueue{ready: make(chan struct{})}
+}
+
+func (q *groupQueue) push(msg envelope) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.messages = append(q.messages, msg)
+	close(q.ready)
+	q.ready = make(chan struct{})
+}
+
+// pop은 메시지가 들어올 때까지 기다렸다가 가장 오래된 메시지를 꺼냅니다.
+// done이 닫히면 errReaderClosed를 반환합니다.
+func (q *groupQueue) pop(ctx context.Context, done <-chan struct{}) (envelope, error) {
+	for {
+		q.mu.Lock()
+		if len(q.messages) > 0 {
+			msg := q.messages[0]
+			q.messages = q.messages[1:]
+			q.mu.Unlock()
+			return msg, nil
+		}
+		if q.closed {
+			q.mu.Unlock()
+			return envelope{}, errBrokerClosed
+		}
+		ready := q.ready
+		q.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return envelope{}, ctx.Err()
+		case <-done:
+			return envelope{}, errReaderClosed
+		case <-ready:
+		}
+	}
+}
+
+func (q *groupQueue) close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return
+	}
+	q.closed = true
+	close(q.ready)
+}
@@ -0,0 +1,335 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NARUBROWN/spine/internal/event/consumer"
+	internalpublish "github.com/NARUBROWN/spine/internal/event/publish"
+	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/event/consume"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+type orderCreated struct {
+	OrderID string `json:"orderId"`
+}
+
+func (e orderCreated) Name() string          { return "order.created" }
+func (e orderCreated) OccurredAt() time.Time { return time.Unix(100, 0) }
+func (e orderCreated) Key() string           { return e.OrderID }
+
+func newTestReader(t *testing.T, broker *Broker, topic, group string) *Reader {
+	t.Helper()
+	reader, err := NewReader(broker, topic, group)
+	if err != nil {
+		t.Fatalf("Reader 생성에 실패했습니다: %v", err)
+	}
+	t.Cleanup(func() { reader.Close() })
+	return reader
+}
+
+func readWithin(t *testing.T, reader *Reader, timeout time.Duration) (*consumer.Message, error) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return reader.Read(ctx)
+}
+
+func TestBroker_FansOutToEveryGroup(t *testing.T) {
+	broker := NewBroker(boot.InMemoryOptions{})
+	defer broker.Close()
+
+	billing := newTestReader(t, broker, "order.created", "billing")
+	shipping := newTestReader(t, broker, "order.created", "shipping")
+
+	ctx := publish.ContextWithHeaders(context.Background(), map[string]string{publish.CorrelationIDHeader: "corr-1"})
+	if err := broker.Publish(ctx, orderCreated{OrderID: "o-1"}); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	for _, reader := range []*Reader{billing, shipping} {
+		msg, err := readWithin(t, reader, time.Second)
+		if err != nil {
+			t.Fatalf("모든 그룹이 메시지를 받아야 합니다: %v", err)
+		}
+		if msg.EventName != "order.created" || string(msg.Payload) != `{"orderId":"o-1"}` {
+			t.Fatalf("메시지가 잘못되었습니다: %s %s", msg.EventName, msg.Payload)
+		}
+		if msg.Metadata.Key != "o-1" || msg.Metadata.CorrelationID != "corr-1" || msg.Metadata.MessageID == "" {
+			t.Fatalf("메타데이터가 채워져야 합니다: %+v", msg.Metadata)
+		}
+	}
+}
+
+func TestBroker_SplitsMessagesWithinGroup(t *testing.T) {
+	broker := NewBroker(boot.InMemoryOptions{})
+	defer broker.Close()
+
+	first := newTestReader(t, broker, "order.created", "")
+	second := newTestReader(t, broker, "order.created", "")
+
+	for _, id := range []string{"o-1", "o-2"} {
+		if err := broker.Publish(context.Background(), orderCreated{OrderID: id}); err != nil {
+			t.Fatalf("예상하지 못한 에러입니다: %v", err)
+		}
+	}
+
+	a, err := readWithin(t, first, time.Second)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	b, err := readWithin(t, second, time.Second)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if a.Metadata.Key != "o-1" || b.Metadata.Key != "o-2" || a.Metadata.Offset != 0 || b.Metadata.Offset != 1 {
+		t.Fatalf("같은 그룹의 Reader는 메시지를 나눠 받아야 합니다: %+v, %+v", a.Metadata, b.Metadata)
+	}
+
+	if _, err := readWithin(t, first, 20*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("같은 메시지를 두 번 받으면 안 됩니다: %v", err)
+	}
+}
+
+func TestBroker_NackRedeliversAfterDelay(t *testing.T) {
+	broker := NewBroker(boot.InMemoryOptions{RedeliveryDelay: 30 * time.Millisecond})
+	defer broker.Close()
+
+	reader := newTestReader(t, broker, "order.created", "billing")
+	if err := broker.Publish(context.Background(), orderCreated{OrderID: "o-1"}); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	msg, err := readWithin(t, reader, time.Second)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if msg.Metadata.Redelivered {
+		t.Fatal("처음 전달된 메시지는 재전달로 표시되면 안 됩니다")
+	}
+	if err := msg.Nack(); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	if _, err := readWithin(t, reader, 10*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("재전달 지연 전에는 메시지가 없어야 합니다: %v", err)
+	}
+
+	again, err := readWithin(t, reader, time.Second)
+	if err != nil {
+		t.Fatalf("NACK된 메시지는 다시 전달되어야 합니다: %v", err)
+	}
+	if !again.Metadata.Redelivered || again.Metadata.MessageID != msg.Metadata.MessageID {
+		t.Fatalf("재전달된 같은 메시지여야 합니다: %+v", again.Metadata)
+	}
+
+	if err := again.Ack(); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if _, err := readWithin(t, reader, 50*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("ACK된 메시지는 다시 전달되면 안 됩니다: %v", err)
+	}
+}
+
+func TestBroker_DeliveryDelay(t *testing.T) {
+	broker := NewBroker(boot.InMemoryOptions{DeliveryDelay: 30 * time.Millisecond})
+	defer broker.Close()
+
+	reader := newTestReader(t, broker, "order.created", "billing")
+	if err := broker.Publish(context.Background(), orderCreated{OrderID: "o-1"}); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	if _, err := readWithin(t, reader, 10*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("전달 지연 전에는 메시지가 없어야 합니다: %v", err)
+	}
+	if _, err := readWithin(t, reader, time.Second); err != nil {
+		t.Fatalf("지연 후에는 메시지가 전달되어야 합니다: %v", err)
+	}
+}
+
+func TestBroker_SubscribeBeforeReaderKeepsMessages(t *testing.T) {
+	broker := NewBroker(boot.InMemoryOptions{})
+	defer broker.Close()
+
+	if err := broker.Publish(context.Background(), orderCreated{OrderID: "dropped"}); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if err := broker.Subscribe("order.created", "billing"); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if err := broker.Publish(context.Background(), orderCreated{OrderID: "kept"}); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	msg, err := readWithin(t, newTestReader(t, broker, "order.created", "billing"), time.Second)
+	if err != nil {
+		t.Fatalf("구독 이후 메시지는 Reader가 열리기 전에도 쌓여야 합니다: %v", err)
+	}
+	if msg.Metadata.Key != "kept" {
+		t.Fatalf("구독 전에 발행된 메시지는 버려져야 합니다: %+v", msg.Metadata)
+	}
+}
+
+func TestReader_DeadLetterPublishesToDestination(t *testing.T) {
+	broker := NewBroker(boot.InMemoryOptions{})
+	defer broker.Close()
+
+	reader := newTestReader(t, broker, "order.created", "billing")
+	dlq := newTestReader(t, broker, "order.created.dlq", "ops")
+	if err := broker.Publish(context.Background(), orderCreated{OrderID: "o-1"}); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	msg, err := readWithin(t, reader, time.Second)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	letter := consumer.DeadLetter{Destination: "order.created.dlq", Err: errors.New("boom"), Attempts: 3}
+	if err := msg.SendToDeadLetter(context.Background(), letter); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	dead, err := readWithin(t, dlq, time.Second)
+	if err != nil {
+		t.Fatalf("Dead Letter 토픽으로 전달되어야 합니다: %v", err)
+	}
+	headers := dead.Metadata.Headers
+	if headers[consumer.DeadLetterErrorHeader] != "boom" || headers[consumer.DeadLetterAttemptsHeader] != "3" || headers[consumer.DeadLetterOriginTopicHeader] != "order.created" {
+		t.Fatalf("실패 정보가 헤더에 기록되어야 합니다: %v", headers)
+	}
+	if string(dead.Payload) != `{"orderId":"o-1"}` {
+		t.Fatalf("원본 본문이 유지되어야 합니다: %s", dead.Payload)
+	}
+}
+
+func TestReader_OrderingKey(t *testing.T) {
+	broker := NewBroker(boot.InMemoryOptions{})
+	defer broker.Close()
+
+	byTopic := newTestReader(t, broker, "order.created", "a")
+	byKey := newTestReader(t, broker, "order.created", "b")
+	byKey.SetOrdering(consume.OrderByKey)
+
+	if err := broker.Publish(context.Background(), orderCreated{OrderID: "o-1"}); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	a, _ := readWithin(t, byTopic, time.Second)
+	b, _ := readWithin(t, byKey, time.Second)
+	if a == nil || a.OrderingKey() != "order.created" {
+		t.Fatalf("기본 순서 단위는 토픽이어야 합니다: %v", a)
+	}
+	if b == nil || b.OrderingKey() != "o-1" {
+		t.Fatalf("OrderByKey면 메시지 key로 순서를 지켜야 합니다: %v", b)
+	}
+}
+
+func TestReader_CloseUnblocksRead(t *testing.T) {
+	broker := NewBroker(boot.InMemoryOptions{})
+	defer broker.Close()
+
+	reader := newTestReader(t, broker, "order.created", "billing")
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := reader.Read(context.Background())
+		errCh <- err
+	}()
+
+	time.Sleep(10 * time.Millisecond)
+	reader.Close()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, errReaderClosed) {
+			t.Fatalf("닫힌 Reader는 에러를 반환해야 합니다: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Close는 대기 중인 Read를 깨워야 합니다")
+	}
+}
+
+func TestBroker_PublishAtDeliversAfterDeliverAt(t *testing.T) {
+	broker := NewBroker(boot.InMemoryOptions{})
+	defer broker.Close()
+
+	reader := newTestReader(t, broker, "order.created", "")
+	publishedAt := time.Now()
+	if err := broker.PublishAt(context.Background(), orderCreated{OrderID: "o-1"}, publishedAt.Add(50*time.Millisecond)); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	if _, err := readWithin(t, reader, 20*time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("전달 시각 전에는 메시지를 받으면 안 됩니다: %v", err)
+	}
+
+	msg, err := readWithin(t, reader, time.Second)
+	if err != nil {
+		t.Fatalf("전달 시각 이후에는 메시지를 받아야 합니다: %v", err)
+	}
+	if elapsed := time.Since(publishedAt); elapsed < 50*time.Millisecond {
+		t.Fatalf("메시지가 너무 일찍 전달되었습니다: %v", elapsed)
+	}
+	if msg.Metadata.Key != "o-1" {
+		t.Fatalf("메시지가 잘못되었습니다: %+v", msg.Metadata)
+	}
+}
+
+func TestBroker_RequestReceivesReplyFromReader(t *testing.T) {
+	broker := NewBroker(boot.InMemoryOptions{})
+	defer broker.Close()
+
+	replies := internalpublish.NewReplyRouter()
+	listener, err := NewReplyListener(broker, replies)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	listener.Start(context.Background())
+	defer listener.Stop()
+
+	requester, err := internalpublish.NewRequester(broker, ReplyTopic, replies)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	reader := newTestReader(t, broker, "order.created", "billing")
+	go func() {
+		msg, err := readWithin(t, reader, time.Second)
+		if err != nil || !msg.IsRequest() {
+			return
+		}
+		_ = msg.Reply(context.Background(), internalpublish.Reply{Body: []byte("accepted:" + msg.Metadata.Key)})
+	}()
+
+	reply, err := requester.Request(context.Background(), orderCreated{OrderID: "o-1"})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if string(reply) != "accepted:o-1" {
+		t.Fatalf("Reader가 보낸 응답을 받아야 합니다: %s", reply)
+	}
+}
+
+func TestReader_PlainMessageIsNotRequest(t *testing.T) {
+	broker := NewBroker(boot.InMemoryOptions{})
+	defer broker.Close()
+
+	reader := newTestReader(t, broker, "order.created", "billing")
+	if err := broker.Publish(context.Background(), orderCreated{OrderID: "o-1"}); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	msg, err := readWithin(t, reader, time.Second)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if msg.IsRequest() {
+		t.Fatal("응답 주소가 없는 메시지는 요청이 아닙니다")
+	}
+	if err := msg.Reply(context.Background(), internalpublish.Reply{}); err == nil {
+		t.Fatal("요청이 아닌 메시지에는 응답할 수 없어야 합니다")
+	}
+}
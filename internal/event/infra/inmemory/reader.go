@@ -0,0 +1,139 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"strconv"
+	"sync"
+
+	"github.com/NARUBROWN/spine/internal/event/consumer"
+	internalpublish "github.com/NARUBROWN/spine/internal/event/publish"
+	"github.com/NARUBROWN/spine/pkg/event"
+	"github.com/NARUBROWN/spine/pkg/event/consume"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+var errReaderClosed = errors.New("in-memory reader is closed")
+
+type Reader struct {
+	broker   *Broker
+	queue    *groupQueue
+	ordering consume.Ordering
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// NewReader는 topic을 group으로 구독하는 Reader를 만듭니다.
+func NewReader(broker *Broker, topic, group string) (*Reader, error) {
+	if broker == nil {
+		return nil, errors.New("in-memory broker cannot be nil")
+	}
+
+	queue, err := broker.subscribe(topic, group)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{broker: broker, queue: queue, done: make(chan struct{})}, nil
+}
+
+func (r *Reader) Read(ctx context.Context) (*consumer.Message, error) {
+	m, err := r.queue.pop(ctx, r.done)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &consumer.Message{
+		EventName: m.topic,
+		Payload:   m.payload,
+		Metadata:  envelopeMetadata(m),
+	}
+
+	// 브로커는 토픽당 파티션 하나로 동작한다.
+	if r.ordering == consume.OrderByKey && m.key != "" {
+		msg.SetOrderingKey(m.key)
+	} else {
+		msg.SetOrderingKey(m.topic)
+	}
+
+	// ACK/Reject: 꺼낸 시점에 큐에서 빠졌으므로 할 일이 없다.
+	msg.SetAckHandler(func() error { return nil })
+	msg.SetRejectHandler(func() error { return nil })
+
+	// NACK: 같은 그룹에 다시 전달
+	msg.SetNackHandler(func() error {
+		r.broker.redeliver(r.queue, m)
+		return nil
+	})
+
+	// Dead Letter: 원본 key/본문/헤더에 실패 정보를 더해 Dead Letter 토픽으로 발행
+	msg.SetDeadLetterHandler(func(ctx context.Context, letter consumer.DeadLetter) error {
+		return r.broker.send(deadLetterEnvelope(m, letter), 0)
+	})
+
+	// Reply: 요청 메시지면 응답을 요청자의 응답 토픽으로 발행
+	if replyTo := m.headers[publish.ReplyToHeader]; replyTo != "" {
+		msg.SetReplyHandler(func(ctx context.Context, reply internalpublish.Reply) error {
+			return r.broker.send(replyEnvelope(m, replyTo, reply), 0)
+		})
+	}
+
+	return msg, nil
+}
+
+// SetOrdering은 동시 처리 시 순서를 지킬 단위를 지정합니다.
+func (r *Reader) SetOrdering(ordering consume.Ordering) {
+	r.ordering = ordering
+}
+
+// Close는 대기 중인 Read를 깨웁니다. 그룹 구독과 큐에 남은 메시지는 유지됩니다.
+func (r *Reader) Close() error {
+	r.closeOnce.Do(func() {
+		close(r.done)
+	})
+	return nil
+}
+
+func envelopeMetadata(m envelope) event.Metadata {
+	return event.Metadata{
+		Headers:       m.headers,
+		Key:           m.key,
+		Offset:        m.offset,
+		Timestamp:     m.timestamp,
+		MessageID:     m.messageID,
+		CorrelationID: m.headers[publish.CorrelationIDHeader],
+		Redelivered:   m.redelivered,
+	}
+}
+
+func deadLetterEnvelope(m envelope, letter consumer.DeadLetter) envelope {
+	headers := maps.Clone(m.headers)
+	if headers == nil {
+		headers = make(map[string]string, 3)
+	}
+	headers[consumer.DeadLetterErrorHeader] = letter.Err.Error()
+	headers[consumer.DeadLetterAttemptsHeader] = strconv.Itoa(letter.Attempts)
+	headers[consumer.DeadLetterOriginTopicHeader] = m.topic
+
+	return envelope{
+		topic:   letter.Destination,
+		key:     m.key,
+		payload: m.payload,
+		headers: headers,
+	}
+}
+
+func replyEnvelope(m envelope, replyTo string, reply internalpublish.Reply) envelope {
+	headers := maps.Clone(reply.Headers)
+	if headers == nil {
+		headers = make(map[string]string, 1)
+	}
+	headers[publish.ReplyCorrelationHeader] = m.headers[publish.ReplyCorrelationHeader]
+
+	return envelope{
+		topic:   replyTo,
+		payload: reply.Body,
+		headers: headers,
+	}
+}
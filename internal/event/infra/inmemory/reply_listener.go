@@ -0,0 +1,69 @@
+package inmemory
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sync"
+
+	internalpublish "github.com/NARUBROWN/spine/internal/event/publish"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+// ReplyTopic은 In-Memory 요청의 응답이 발행되는 토픽입니다.
+const ReplyTopic = "spine.replies"
+
+// ReplyListener는 응답 토픽을 읽어 기다리는 요청에 응답을 전달합니다.
+type ReplyListener struct {
+	reader  *Reader
+	replies *internalpublish.ReplyRouter
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewReplyListener(broker *Broker, replies *internalpublish.ReplyRouter) (*ReplyListener, error) {
+	reader, err := NewReader(broker, ReplyTopic, defaultGroupID)
+	if err != nil {
+		return nil, err
+	}
+	return &ReplyListener{reader: reader, replies: replies}, nil
+}
+
+// Start는 응답 수신을 백그라운드에서 시작합니다.
+func (l *ReplyListener) Start(ctx context.Context) {
+	ctx, l.cancel = context.WithCancel(ctx)
+
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		l.run(ctx)
+	}()
+}
+
+// Stop은 응답 수신을 멈춥니다.
+func (l *ReplyListener) Stop() {
+	if l.cancel != nil {
+		l.cancel()
+	}
+	_ = l.reader.Close()
+	l.wg.Wait()
+}
+
+func (l *ReplyListener) run(ctx context.Context) {
+	for {
+		msg, err := l.reader.Read(ctx)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, errReaderClosed) {
+				return
+			}
+			log.Printf("[InMemory][Reply] Failed to read reply: %v", err)
+			continue
+		}
+
+		correlationID := msg.Metadata.Header(publish.ReplyCorrelationHeader)
+		if !l.replies.Deliver(correlationID, internalpublish.Reply{Body: msg.Payload, Headers: msg.Metadata.Headers}) {
+			log.Printf("[InMemory][Reply] Discarding reply with no waiting request (correlation=%s)", correlationID)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package inmemory
+
+import (
+	"github.com/NARUBROWN/spine/internal/event/consumer"
+	"github.com/NARUBROWN/spine/pkg/boot"
+)
+
+type RunnerFactory struct {
+	broker *Broker
+	opts   boot.InMemoryOptions
+}
+
+func NewRunnerFactory(broker *Broker, opts boot.InMemoryOptions) *RunnerFactory {
+	return &RunnerFactory{broker: broker, opts: opts}
+}
+
+func (f *RunnerFactory) Build(registration consumer.Registration) (consumer.Reader, error) {
+	reader, err := NewReader(f.broker, registration.Topic, f.opts.GroupID)
+	if err != nil {
+		return nil, err
+	}
+
+	reader.SetOrdering(registration.Options.Ordering)
+	return reader, nil
+}
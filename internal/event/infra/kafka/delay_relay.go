@@ -0,0 +1,245 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/segmentio/kafka-go"
+)
+
+// 지연 Topic 메시지의 헤더 키
+const (
+	DelayDeliverAtHeader   = "x-spine-deliver-at"
+	DelayTargetTopicHeader = "x-spine-target-topic"
+)
+
+const (
+	defaultDelayGroupID     = "spine-delay"
+	defaultDelayMaxInFlight = 1000
+	delayRetryBackoff       = time.Second
+)
+
+type kafkaMessageFetcher interface {
+	FetchMessage(ctx context.Context) (kafka.Message, error)
+	CommitMessages(ctx context.Context, msgs ...kafka.Message) error
+	Close() error
+}
+
+/*
+DelayRelay는 지연 Topic의 메시지를 읽어 전달 시각이 되면 원래 Topic으로 옮깁니다.
+
+  - 메시지마다 따로 대기하므로, 긴 지연 메시지가 뒤의 짧은 지연 메시지를 막지 않습니다.
+  - 동시에 대기하는 메시지는 DelayMaxInFlight개로 제한하며, 가득 차면 자리가 날 때까지 더 읽지 않습니다.
+  - offset은 앞선 메시지가 모두 옮겨진 뒤에만 커밋하므로, 재시작하면 옮기지 못한 메시지부터 다시 읽습니다.
+    (이미 옮긴 메시지가 다시 전달될 수 있으므로 Consumer는 X-Message-Id로 중복을 걸러낼 수 있습니다)
+*/
+type DelayRelay struct {
+	fetcher kafkaMessageFetcher
+	writer  kafkaMessageWriter
+	offsets *offsetTracker
+	// 대기 중인 메시지 수를 제한하는 세마포어
+	inflight chan struct{}
+	// 커밋 순서가 뒤바뀌지 않도록 offset 계산과 커밋을 함께 직렬화한다.
+	commitMu sync.Mutex
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewDelayRelay(opts boot.KafkaOptions) (*DelayRelay, error) {
+	if len(opts.Brokers) == 0 {
+		return nil, errors.New("Kafka brokers are not configured")
+	}
+	if opts.Write == nil || opts.Write.DelayTopic == "" {
+		return nil, errors.New("Kafka delay topic is not configured")
+	}
+
+	groupID := opts.Write.DelayGroupID
+	if groupID == "" {
+		groupID = defaultDelayGroupID
+	}
+
+	fetcher := kafka.NewReader(kafka.ReaderConfig{
+		Brokers: opts.Brokers,
+		Topic:   opts.Write.DelayTopic,
+		GroupID: groupID,
+	})
+	writer := &kafka.Writer{
+		Addr:     kafka.TCP(opts.Brokers...),
+		Balancer: &kafka.LeastBytes{},
+	}
+	return newDelayRelay(fetcher, writer, opts.Write.DelayMaxInFlight), nil
+}
+
+func newDelayRelay(fetcher kafkaMessageFetcher, writer kafkaMessageWriter, maxInFlight int) *DelayRelay {
+	if maxInFlight <= 0 {
+		maxInFlight = defaultDelayMaxInFlight
+	}
+	return &DelayRelay{
+		fetcher:  fetcher,
+		writer:   writer,
+		offsets:  newOffsetTracker(),
+		inflight: make(chan struct{}, maxInFlight),
+	}
+}
+
+// Start는 relay를 백그라운드에서 시작합니다.
+func (r *DelayRelay) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.run(ctx)
+	}()
+}
+
+// Stop은 relay를 멈춥니다. 아직 옮기지 않은 메시지는 커밋하지 않으므로 다음 실행 때 다시 읽습니다.
+func (r *DelayRelay) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+
+	if err := r.fetcher.Close(); err != nil {
+		log.Printf("[Kafka][Delay] Failed to close delay reader: %v", err)
+	}
+	if err := r.writer.Close(); err != nil {
+		log.Printf("[Kafka][Delay] Failed to close delay writer: %v", err)
+	}
+}
+
+func (r *DelayRelay) run(ctx context.Context) {
+	for {
+		// 대기 중인 메시지가 가득 차면 자리가 날 때까지 더 읽지 않는다.
+		select {
+		case r.inflight <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+
+		m, err := r.fetcher.FetchMessage(ctx)
+		if err != nil {
+			<-r.inflight
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("[Kafka][Delay] Failed to read delayed message: %v", err)
+			continue
+		}
+
+		epoch := r.offsets.track(m.Partition, m.Offset)
+
+		target, deliverAt, err := delayTarget(m)
+		if err != nil {
+			// 옮길 수 없는 메시지는 건너뛴다.
+			log.Printf("[Kafka][Delay] Discarding delayed message (partition=%d, offset=%d): %v", m.Partition, m.Offset, err)
+			r.complete(m, epoch)
+			<-r.inflight
+			continue
+		}
+
+		r.wg.Add(1)
+		go func() {
+			defer r.wg.Done()
+			defer func() { <-r.inflight }()
+			r.forward(ctx, m, epoch, target, deliverAt)
+		}()
+	}
+}
+
+// forward는 전달 시각까지 기다렸다가 원래 Topic에 기록합니다. 실패하면 종료될 때까지 다시 시도합니다.
+func (r *DelayRelay) forward(ctx context.Context, m kafka.Message, epoch uint64, target string, deliverAt time.Time) {
+	timer := time.NewTimer(time.Until(deliverAt))
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		if err := r.writer.WriteMessages(ctx, forwardedMessage(m, target)); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("[Kafka][Delay] Failed to forward delayed message to '%s': %v", target, err)
+			timer.Reset(delayRetryBackoff)
+			continue
+		}
+
+		r.complete(m, epoch)
+		return
+	}
+}
+
+// complete는 메시지를 옮겼다고 기록하고, 연속으로 끝난 가장 큰 offset까지 커밋합니다.
+func (r *DelayRelay) complete(m kafka.Message, epoch uint64) {
+	r.commitMu.Lock()
+	defer r.commitMu.Unlock()
+
+	offset, ok := r.offsets.complete(m.Partition, epoch, m.Offset)
+	if !ok {
+		return
+	}
+	if err := r.fetcher.CommitMessages(context.Background(), kafka.Message{
+		Topic:     m.Topic,
+		Partition: m.Partition,
+		Offset:    offset,
+	}); err != nil {
+		log.Printf("[Kafka][Delay] Failed to commit delayed messages: %v", err)
+	}
+}
+
+// delayedMessage는 메시지를 지연 Topic에 기록할 수 있도록 원래 Topic과 전달 시각을 헤더에 담습니다.
+func delayedMessage(msg kafka.Message, delayTopic string, deliverAt time.Time) kafka.Message {
+	msg.Headers = append(slices.Clone(msg.Headers),
+		kafka.Header{Key: DelayTargetTopicHeader, Value: []byte(msg.Topic)},
+		kafka.Header{Key: DelayDeliverAtHeader, Value: []byte(deliverAt.UTC().Format(time.RFC3339Nano))},
+	)
+	msg.Topic = delayTopic
+	return msg
+}
+
+func delayTarget(m kafka.Message) (string, time.Time, error) {
+	var target, deliverAt string
+	for _, h := range m.Headers {
+		switch h.Key {
+		case DelayTargetTopicHeader:
+			target = string(h.Value)
+		case DelayDeliverAtHeader:
+			deliverAt = string(h.Value)
+		}
+	}
+	if target == "" {
+		return "", time.Time{}, fmt.Errorf("missing %s header", DelayTargetTopicHeader)
+	}
+
+	at, err := time.Parse(time.RFC3339Nano, deliverAt)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid %s header: %w", DelayDeliverAtHeader, err)
+	}
+	return target, at, nil
+}
+
+// forwardedMessage는 지연 헤더를 지우고 원래 Topic으로 보낼 메시지를 만듭니다.
+func forwardedMessage(m kafka.Message, target string) kafka.Message {
+	headers := slices.DeleteFunc(slices.Clone(m.Headers), func(h kafka.Header) bool {
+		return h.Key == DelayTargetTopicHeader || h.Key == DelayDeliverAtHeader
+	})
+
+	return kafka.Message{
+		Topic:   target,
+		Key:     m.Key,
+		Value:   m.Value,
+		Headers: headers,
+		Time:    m.Time,
+	}
+}
@@ -0,0 +1,135 @@
+package kafka
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+type fakeDelayFetcher struct {
+	msgs      chan kafka.Message
+	mu        sync.Mutex
+	committed []int64
+}
+
+func (f *fakeDelayFetcher) FetchMessage(ctx context.Context) (kafka.Message, error) {
+	select {
+	case m := <-f.msgs:
+		return m, nil
+	case <-ctx.Done():
+		return kafka.Message{}, ctx.Err()
+	}
+}
+
+func (f *fakeDelayFetcher) CommitMessages(ctx context.Context, msgs ...kafka.Message) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, m := range msgs {
+		f.committed = append(f.committed, m.Offset)
+	}
+	return nil
+}
+
+func (f *fakeDelayFetcher) Close() error { return nil }
+
+func (f *fakeDelayFetcher) commits() []int64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]int64(nil), f.committed...)
+}
+
+type syncKafkaWriter struct {
+	mu       sync.Mutex
+	messages []kafka.Message
+	written  chan struct{}
+}
+
+func (w *syncKafkaWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	w.mu.Lock()
+	w.messages = append(w.messages, msgs...)
+	w.mu.Unlock()
+	w.written <- struct{}{}
+	return nil
+}
+
+func (w *syncKafkaWriter) Close() error { return nil }
+
+func TestDelayRelay_ForwardsWhenDueWithoutHeadOfLineBlocking(t *testing.T) {
+	fetcher := &fakeDelayFetcher{msgs: make(chan kafka.Message, 2)}
+	writer := &syncKafkaWriter{written: make(chan struct{}, 2)}
+	relay := newDelayRelay(fetcher, writer, 0)
+
+	now := time.Now()
+	long := delayedMessage(kafka.Message{Topic: "order.expired", Key: []byte("o-1"), Value: []byte("long")}, "spine.delay", now.Add(time.Hour))
+	long.Offset = 0
+	short := delayedMessage(kafka.Message{Topic: "order.reminded", Key: []byte("o-2"), Value: []byte("short")}, "spine.delay", now.Add(20*time.Millisecond))
+	short.Offset = 1
+	fetcher.msgs <- long
+	fetcher.msgs <- short
+
+	relay.Start(context.Background())
+
+	select {
+	case <-writer.written:
+	case <-time.After(2 * time.Second):
+		t.Fatal("전달 시각이 된 메시지는 앞의 긴 지연 메시지와 상관없이 옮겨져야 합니다")
+	}
+	relay.Stop()
+
+	writer.mu.Lock()
+	forwarded := writer.messages
+	writer.mu.Unlock()
+	if len(forwarded) != 1 || forwarded[0].Topic != "order.reminded" || string(forwarded[0].Value) != "short" {
+		t.Fatalf("원래 Topic으로 옮겨져야 합니다: %+v", forwarded)
+	}
+	for _, h := range forwarded[0].Headers {
+		if h.Key == DelayDeliverAtHeader || h.Key == DelayTargetTopicHeader {
+			t.Fatalf("지연 헤더는 제거되어야 합니다: %v", forwarded[0].Headers)
+		}
+	}
+	if commits := fetcher.commits(); len(commits) != 0 {
+		t.Fatalf("앞선 메시지를 옮기기 전에는 커밋하면 안 됩니다: %v", commits)
+	}
+}
+
+func TestDelayRelay_StopsFetchingWhenInFlightIsFull(t *testing.T) {
+	fetcher := &fakeDelayFetcher{msgs: make(chan kafka.Message, 2)}
+	writer := &syncKafkaWriter{written: make(chan struct{}, 2)}
+	relay := newDelayRelay(fetcher, writer, 1)
+
+	now := time.Now()
+	long := delayedMessage(kafka.Message{Topic: "order.expired", Value: []byte("long")}, "spine.delay", now.Add(time.Hour))
+	long.Offset = 0
+	short := delayedMessage(kafka.Message{Topic: "order.reminded", Value: []byte("short")}, "spine.delay", now)
+	short.Offset = 1
+	fetcher.msgs <- long
+	fetcher.msgs <- short
+
+	relay.Start(context.Background())
+
+	select {
+	case <-writer.written:
+		t.Fatal("대기 중인 메시지가 가득 차면 다음 메시지를 읽으면 안 됩니다")
+	case <-time.After(100 * time.Millisecond):
+	}
+	relay.Stop()
+
+	if remaining := len(fetcher.msgs); remaining != 1 {
+		t.Fatalf("읽지 않은 메시지가 남아 있어야 합니다. 남은 개수=%d", remaining)
+	}
+}
+
+func TestDelayTarget_RejectsMessagesWithoutHeaders(t *testing.T) {
+	if _, _, err := delayTarget(kafka.Message{Topic: "spine.delay"}); err == nil {
+		t.Fatal("지연 헤더가 없으면 에러가 발생해야 합니다")
+	}
+
+	deliverAt := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+	target, at, err := delayTarget(delayedMessage(kafka.Message{Topic: "dev-order.expired"}, "spine.delay", deliverAt))
+	if err != nil || target != "dev-order.expired" || !at.Equal(deliverAt) {
+		t.Fatalf("지연 헤더가 잘못 해석되었습니다: %s %v (%v)", target, at, err)
+	}
+}
@@ -0,0 +1,81 @@
+package kafka
+
+import "sync"
+
+/*
+offsetTracker는 동시 처리 중인 메시지의 offset을 파티션별로 추적합니다.
+Kafka는 파티션마다 "여기까지 처리했다"는 offset 하나만 커밋하므로,
+뒤의 메시지가 먼저 끝나도 앞선 offset이 모두 끝나기 전에는 커밋하지 않습니다.
+*/
+type offsetTracker struct {
+	mu         sync.Mutex
+	partitions map[int]*partitionOffsets
+}
+
+type partitionOffsets struct {
+	// epoch는 파티션을 다시 읽기 시작할 때마다 증가하며, 이전 epoch의 완료 기록은 무시합니다.
+	epoch uint64
+	// 읽은 순서(오름차순)대로 쌓인, 아직 커밋되지 않은 offset
+	pending []int64
+	// 추적 중인 offset의 처리 완료 여부
+	done map[int64]bool
+}
+
+func newOffsetTracker() *offsetTracker {
+	return &offsetTracker{partitions: make(map[int]*partitionOffsets)}
+}
+
+// track은 읽은 메시지의 offset을 처리 대기 목록에 추가하고, complete에 넘길 epoch를 반환합니다.
+// 마지막으로 읽은 offset보다 크지 않은 offset이 오면 리밸런스 등으로 파티션을 커밋 위치부터
+// 다시 읽는 것이므로, 이전 상태를 버리고 새 epoch로 추적합니다.
+func (t *offsetTracker) track(partition int, offset int64) uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p := t.partitions[partition]
+	if p == nil {
+		p = &partitionOffsets{done: make(map[int64]bool)}
+		t.partitions[partition] = p
+	}
+	if n := len(p.pending); n > 0 && offset <= p.pending[n-1] {
+		p.epoch++
+		p.pending = nil
+		clear(p.done)
+	}
+
+	p.pending = append(p.pending, offset)
+	p.done[offset] = false
+	return p.epoch
+}
+
+// complete는 offset 처리를 끝냈다고 기록하고, 새로 커밋할 수 있는 가장 큰 offset을 반환합니다.
+// 다시 읽기 전(이전 epoch)에 읽은 메시지의 완료는 무시합니다.
+func (t *offsetTracker) complete(partition int, epoch uint64, offset int64) (int64, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p := t.partitions[partition]
+	if p == nil || p.epoch != epoch {
+		return 0, false
+	}
+	if _, ok := p.done[offset]; !ok {
+		return 0, false
+	}
+	p.done[offset] = true
+
+	committable := int64(-1)
+	for len(p.pending) > 0 {
+		head := p.pending[0]
+		if !p.done[head] {
+			break
+		}
+		delete(p.done, head)
+		p.pending = p.pending[1:]
+		committable = head
+	}
+
+	if committable < 0 {
+		return 0, false
+	}
+	return committable, true
+}
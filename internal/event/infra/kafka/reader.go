@@ -3,15 +3,32 @@ package kafka
 import (
 	"context"
 	"errors"
+	"maps"
+	"strconv"
+	"sync"
 
 	"github.com/NARUBROWN/spine/internal/event/consumer"
+	internalpublish "github.com/NARUBROWN/spine/internal/event/publish"
 	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/event"
+	"github.com/NARUBROWN/spine/pkg/event/consume"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
 	"github.com/segmentio/kafka-go"
 )
 
 type Reader struct {
 	reader *kafka.Reader
 	opts   boot.KafkaOptions
+	// Dead Letter 토픽으로 메시지를 보내는 writer (정책이 없으면 nil)
+	deadLetterWriter kafkaMessageWriter
+	// 요청 메시지의 응답을 보내는 writer
+	replyWriter kafkaMessageWriter
+	// 동시 처리 시 순서를 지킬 단위
+	ordering consume.Ordering
+	offsets  *offsetTracker
+	// 커밋 순서가 뒤바뀌지 않도록 offset 계산과 커밋을 함께 직렬화한다.
+	commitMu  sync.Mutex
+	committer func(ctx context.Context, msgs ...kafka.Message) error
 }
 
 func NewKafkaReader(topic string, opts boot.KafkaOptions) (*Reader, error) {
@@ -37,6 +54,13 @@ func NewKafkaReader(topic string, opts boot.KafkaOptions) (*Reader, error) {
 	return &Reader{
 		reader: reader,
 		opts:   opts,
+		// kafka.Writer는 처음 기록할 때 연결하므로 요청 메시지가 없으면 비용이 들지 않는다.
+		replyWriter: &kafka.Writer{
+			Addr:     kafka.TCP(opts.Brokers...),
+			Balancer: &kafka.LeastBytes{},
+		},
+		offsets:   newOffsetTracker(),
+		committer: reader.CommitMessages,
 	}, nil
 }
 
@@ -49,23 +73,141 @@ func (r *Reader) Read(ctx context.Context) (*consumer.Message, error) {
 	msg := &consumer.Message{
 		EventName: m.Topic,
 		Payload:   m.Value,
+		Metadata:  messageMetadata(m),
 	}
 
-	// ACK 콜백 설정: 핸들러 성공 시 커밋
+	epoch := r.offsets.track(m.Partition, m.Offset)
+	if r.ordering == consume.OrderByKey && len(m.Key) > 0 {
+		msg.SetOrderingKey(string(m.Key))
+	} else {
+		msg.SetOrderingKey(strconv.Itoa(m.Partition))
+	}
+
+	// ACK 콜백 설정: 핸들러 성공 시 앞선 offset이 모두 끝났으면 커밋
 	msg.SetAckHandler(func() error {
-		return r.reader.CommitMessages(context.Background(), m)
+		return r.commit(m, epoch)
+	})
+
+	// Reject 콜백 설정: 재처리하지 않을 메시지는 커밋해서 건너뜀
+	msg.SetRejectHandler(func() error {
+		return r.commit(m, epoch)
+	})
+
+	// Dead Letter 콜백 설정: 원본 key/value/header에 실패 정보를 더해 DLQ 토픽으로 보냄
+	msg.SetDeadLetterHandler(func(ctx context.Context, letter consumer.DeadLetter) error {
+		if r.deadLetterWriter == nil {
+			return errors.New("Kafka dead letter writer is not configured")
+		}
+		return r.deadLetterWriter.WriteMessages(ctx, deadLetterMessage(m, letter))
 	})
 
-	// NACK 콜백 설정: Kafka는 명시적 NACK이 없으므로 커밋하지 않음
-	// (컨슈머 그룹 재시작 시 재처리됨)
+	// Reply 콜백 설정: 요청 메시지면 응답을 요청자의 응답 Topic에 기록
+	if replyTo := msg.Metadata.Header(publish.ReplyToHeader); replyTo != "" {
+		msg.SetReplyHandler(func(ctx context.Context, reply internalpublish.Reply) error {
+			return r.replyWriter.WriteMessages(ctx, replyMessage(msg.Metadata, replyTo, reply))
+		})
+	}
+
+	// NACK 콜백 설정: Kafka는 명시적 NACK이 없으므로 이 offset을 직접 커밋하지 않음
+	// (뒤의 메시지가 커밋되면 함께 건너뛰고, 마지막 메시지라면 컨슈머 그룹 재시작 시 재처리됨)
 	msg.SetNackHandler(func() error {
-		// Kafka는 명시적 NACK 대신 커밋하지 않으면 됨
-		return nil
+		return r.skip(m, epoch)
 	})
 
 	return msg, nil
 }
 
+// commit은 메시지 처리를 끝냈다고 기록하고, 연속으로 끝난 가장 큰 offset까지 커밋합니다.
+func (r *Reader) commit(m kafka.Message, epoch uint64) error {
+	return r.complete(m, epoch, true)
+}
+
+// skip은 NACK된 메시지를 끝난 것으로 기록해 뒤의 offset 커밋을 막지 않게 합니다.
+// 커밋할 수 있는 offset이 NACK된 메시지 자신이면 커밋하지 않습니다.
+func (r *Reader) skip(m kafka.Message, epoch uint64) error {
+	return r.complete(m, epoch, false)
+}
+
+func (r *Reader) complete(m kafka.Message, epoch uint64, commitSelf bool) error {
+	r.commitMu.Lock()
+	defer r.commitMu.Unlock()
+
+	offset, ok := r.offsets.complete(m.Partition, epoch, m.Offset)
+	if !ok || (!commitSelf && offset == m.Offset) {
+		return nil
+	}
+	return r.committer(context.Background(), kafka.Message{
+		Topic:     m.Topic,
+		Partition: m.Partition,
+		Offset:    offset,
+	})
+}
+
+// SetOrdering은 동시 처리 시 순서를 지킬 단위를 지정합니다.
+func (r *Reader) SetOrdering(ordering consume.Ordering) {
+	r.ordering = ordering
+}
+
 func (r *Reader) Close() error {
+	if r.deadLetterWriter != nil {
+		_ = r.deadLetterWriter.Close()
+	}
+	if r.replyWriter != nil {
+		_ = r.replyWriter.Close()
+	}
 	return r.reader.Close()
 }
+
+// EnableDeadLetter는 Dead Letter 전송에 사용할 writer를 구성합니다.
+func (r *Reader) EnableDeadLetter() {
+	r.deadLetterWriter = &kafka.Writer{
+		Addr:     kafka.TCP(r.opts.Brokers...),
+		Balancer: &kafka.LeastBytes{},
+	}
+}
+
+func messageMetadata(m kafka.Message) event.Metadata {
+	headers := make(map[string]string, len(m.Headers))
+	for _, h := range m.Headers {
+		headers[h.Key] = string(h.Value)
+	}
+
+	return event.Metadata{
+		Headers:   headers,
+		Key:       string(m.Key),
+		Partition: m.Partition,
+		Offset:    m.Offset,
+		Timestamp: m.Time,
+	}
+}
+
+func deadLetterMessage(m kafka.Message, letter consumer.DeadLetter) kafka.Message {
+	headers := make([]kafka.Header, 0, len(m.Headers)+3)
+	headers = append(headers, m.Headers...)
+	headers = append(headers,
+		kafka.Header{Key: consumer.DeadLetterErrorHeader, Value: []byte(letter.Err.Error())},
+		kafka.Header{Key: consumer.DeadLetterAttemptsHeader, Value: []byte(strconv.Itoa(letter.Attempts))},
+		kafka.Header{Key: consumer.DeadLetterOriginTopicHeader, Value: []byte(m.Topic)},
+	)
+
+	return kafka.Message{
+		Topic:   letter.Destination,
+		Key:     m.Key,
+		Value:   m.Value,
+		Headers: headers,
+	}
+}
+
+func replyMessage(metadata event.Metadata, replyTo string, reply internalpublish.Reply) kafka.Message {
+	headers := maps.Clone(reply.Headers)
+	if headers == nil {
+		headers = make(map[string]string, 1)
+	}
+	headers[publish.ReplyCorrelationHeader] = metadata.Header(publish.ReplyCorrelationHeader)
+
+	return kafka.Message{
+		Topic:   replyTo,
+		Value:   reply.Body,
+		Headers: kafkaHeaders(headers),
+	}
+}
@@ -0,0 +1,184 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/NARUBROWN/spine/internal/event/consumer"
+	internalpublish "github.com/NARUBROWN/spine/internal/event/publish"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+	"github.com/segmentio/kafka-go"
+)
+
+func TestDeadLetterMessage_KeepsOriginalAndAddsFailureHeaders(t *testing.T) {
+	original := kafka.Message{
+		Topic:   "order.created",
+		Key:     []byte("order-1"),
+		Value:   []byte(`{"id":1}`),
+		Headers: []kafka.Header{{Key: "trace", Value: []byte("abc")}},
+	}
+
+	msg := deadLetterMessage(original, consumer.DeadLetter{
+		Destination: "order.created.dlq",
+		Err:         errors.New("boom"),
+		Attempts:    4,
+	})
+
+	if msg.Topic != "order.created.dlq" || string(msg.Key) != "order-1" || string(msg.Value) != `{"id":1}` {
+		t.Fatalf("원본 key/value가 보존되어야 합니다: %+v", msg)
+	}
+
+	headers := make(map[string]string, len(msg.Headers))
+	for _, h := range msg.Headers {
+		headers[h.Key] = string(h.Value)
+	}
+	if headers["trace"] != "abc" ||
+		headers[consumer.DeadLetterErrorHeader] != "boom" ||
+		headers[consumer.DeadLetterAttemptsHeader] != "4" ||
+		headers[consumer.DeadLetterOriginTopicHeader] != "order.created" {
+		t.Fatalf("Dead Letter 헤더가 잘못되었습니다: %v", headers)
+	}
+}
+
+func TestReplyMessage_WritesToReplyTopicWithCorrelation(t *testing.T) {
+	request := messageMetadata(kafka.Message{
+		Topic: "quote.requested",
+		Headers: []kafka.Header{
+			{Key: publish.ReplyToHeader, Value: []byte("quotes.reply")},
+			{Key: publish.ReplyCorrelationHeader, Value: []byte("req-1")},
+		},
+	})
+
+	msg := replyMessage(request, "quotes.reply", internalpublish.Reply{
+		Body:    []byte(`{"price":100}`),
+		Headers: map[string]string{"content-type": "application/json"},
+	})
+
+	if msg.Topic != "quotes.reply" || string(msg.Value) != `{"price":100}` {
+		t.Fatalf("응답은 응답 Topic에 기록되어야 합니다: %+v", msg)
+	}
+	headers := messageMetadata(msg)
+	if headers.Header(publish.ReplyCorrelationHeader) != "req-1" || headers.Header("content-type") != "application/json" {
+		t.Fatalf("응답 헤더가 잘못되었습니다: %v", headers.Headers)
+	}
+}
+
+func TestReader_CommitWaitsForEarlierOffsets(t *testing.T) {
+	var committed []int64
+	reader := &Reader{
+		offsets: newOffsetTracker(),
+		committer: func(ctx context.Context, msgs ...kafka.Message) error {
+			for _, m := range msgs {
+				committed = append(committed, m.Offset)
+			}
+			return nil
+		},
+	}
+
+	for _, offset := range []int64{10, 11, 12} {
+		reader.offsets.track(0, offset)
+	}
+	reader.offsets.track(1, 5)
+
+	if err := reader.commit(kafka.Message{Partition: 0, Offset: 12}, 0); err != nil {
+		t.Fatalf("커밋 실패: %v", err)
+	}
+	if err := reader.commit(kafka.Message{Partition: 0, Offset: 11}, 0); err != nil {
+		t.Fatalf("커밋 실패: %v", err)
+	}
+	if len(committed) != 0 {
+		t.Fatalf("앞선 offset이 끝나기 전에는 커밋하면 안 됩니다: %v", committed)
+	}
+
+	if err := reader.commit(kafka.Message{Partition: 1, Offset: 5}, 0); err != nil {
+		t.Fatalf("커밋 실패: %v", err)
+	}
+	if err := reader.commit(kafka.Message{Partition: 0, Offset: 10}, 0); err != nil {
+		t.Fatalf("커밋 실패: %v", err)
+	}
+	if len(committed) != 2 || committed[0] != 5 || committed[1] != 12 {
+		t.Fatalf("파티션별로 연속된 가장 큰 offset을 커밋해야 합니다: %v", committed)
+	}
+}
+
+func newCommitRecordingReader(committed *[]int64) *Reader {
+	return &Reader{
+		offsets: newOffsetTracker(),
+		committer: func(ctx context.Context, msgs ...kafka.Message) error {
+			for _, m := range msgs {
+				*committed = append(*committed, m.Offset)
+			}
+			return nil
+		},
+	}
+}
+
+func TestReader_NackDoesNotBlockLaterCommits(t *testing.T) {
+	var committed []int64
+	reader := newCommitRecordingReader(&committed)
+
+	epoch := reader.offsets.track(0, 10)
+	reader.offsets.track(0, 11)
+
+	if err := reader.skip(kafka.Message{Partition: 0, Offset: 10}, epoch); err != nil {
+		t.Fatalf("NACK 처리 실패: %v", err)
+	}
+	if len(committed) != 0 {
+		t.Fatalf("NACK된 offset은 스스로 커밋되면 안 됩니다: %v", committed)
+	}
+
+	if err := reader.commit(kafka.Message{Partition: 0, Offset: 11}, epoch); err != nil {
+		t.Fatalf("커밋 실패: %v", err)
+	}
+	if len(committed) != 1 || committed[0] != 11 {
+		t.Fatalf("NACK 뒤의 ACK는 두 offset을 모두 지나 커밋해야 합니다: %v", committed)
+	}
+	if p := reader.offsets.partitions[0]; len(p.pending) != 0 || len(p.done) != 0 {
+		t.Fatalf("끝난 offset은 추적 목록에서 제거되어야 합니다: pending=%v done=%v", p.pending, p.done)
+	}
+}
+
+func TestReader_RefetchedPartitionResetsTrackedOffsets(t *testing.T) {
+	var committed []int64
+	reader := newCommitRecordingReader(&committed)
+
+	stale := reader.offsets.track(0, 10)
+	reader.offsets.track(0, 11)
+
+	// 리밸런스 후 커밋 위치(10)부터 다시 읽음
+	epoch := reader.offsets.track(0, 10)
+	if epoch == stale {
+		t.Fatal("다시 읽은 파티션은 새 epoch로 추적해야 합니다")
+	}
+
+	// 이전 epoch에서 처리 중이던 메시지의 완료는 무시
+	if err := reader.commit(kafka.Message{Partition: 0, Offset: 11}, stale); err != nil {
+		t.Fatalf("커밋 실패: %v", err)
+	}
+	if err := reader.commit(kafka.Message{Partition: 0, Offset: 10}, epoch); err != nil {
+		t.Fatalf("커밋 실패: %v", err)
+	}
+	if len(committed) != 1 || committed[0] != 10 {
+		t.Fatalf("다시 읽은 offset 기준으로 커밋해야 합니다: %v", committed)
+	}
+}
+
+func TestMessageMetadata_FromKafkaMessage(t *testing.T) {
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	meta := messageMetadata(kafka.Message{
+		Key:       []byte("order-1"),
+		Partition: 2,
+		Offset:    99,
+		Time:      at,
+		Headers:   []kafka.Header{{Key: "Authorization", Value: []byte("Bearer t")}},
+	})
+
+	if meta.Key != "order-1" || meta.Partition != 2 || meta.Offset != 99 || !meta.Timestamp.Equal(at) {
+		t.Fatalf("metadata가 잘못되었습니다: %+v", meta)
+	}
+	if meta.Header("authorization") != "Bearer t" {
+		t.Fatalf("header는 대소문자 구분 없이 조회되어야 합니다: %+v", meta.Headers)
+	}
+}
@@ -0,0 +1,104 @@
+package kafka
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"log"
+	"sync"
+
+	internalpublish "github.com/NARUBROWN/spine/internal/event/publish"
+	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+	"github.com/segmentio/kafka-go"
+)
+
+/*
+ReplyListener는 응답 Topic을 읽어 기다리는 요청에 응답을 전달합니다.
+
+인스턴스마다 별도의 Consumer Group으로 응답 Topic 전체를 읽고, 자기가 보낸 요청의 응답만 전달합니다.
+새 Group은 가장 최근 offset부터 읽으므로 시작 전에 기록된 응답은 받지 않습니다.
+*/
+type ReplyListener struct {
+	fetcher kafkaMessageFetcher
+	replies *internalpublish.ReplyRouter
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewReplyListener(opts boot.KafkaOptions, replies *internalpublish.ReplyRouter) (*ReplyListener, error) {
+	if len(opts.Brokers) == 0 {
+		return nil, errors.New("Kafka brokers are not configured")
+	}
+	if opts.Write == nil || opts.Write.ReplyTopic == "" {
+		return nil, errors.New("Kafka reply topic is not configured")
+	}
+
+	groupID := opts.Write.ReplyGroupID
+	if groupID == "" {
+		groupID = newReplyGroupID()
+	}
+
+	return &ReplyListener{
+		fetcher: kafka.NewReader(kafka.ReaderConfig{
+			Brokers:     opts.Brokers,
+			Topic:       opts.Write.ReplyTopic,
+			GroupID:     groupID,
+			StartOffset: kafka.LastOffset,
+		}),
+		replies: replies,
+	}, nil
+}
+
+// Start는 응답 수신을 백그라운드에서 시작합니다.
+func (l *ReplyListener) Start(ctx context.Context) {
+	ctx, l.cancel = context.WithCancel(ctx)
+
+	l.wg.Add(1)
+	go func() {
+		defer l.wg.Done()
+		l.run(ctx)
+	}()
+}
+
+// Stop은 응답 수신을 멈춥니다.
+func (l *ReplyListener) Stop() {
+	if l.cancel != nil {
+		l.cancel()
+	}
+	l.wg.Wait()
+
+	if err := l.fetcher.Close(); err != nil {
+		log.Printf("[Kafka][Reply] Failed to close reply reader: %v", err)
+	}
+}
+
+func (l *ReplyListener) run(ctx context.Context) {
+	for {
+		m, err := l.fetcher.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			log.Printf("[Kafka][Reply] Failed to read reply: %v", err)
+			continue
+		}
+
+		metadata := messageMetadata(m)
+		correlationID := metadata.Header(publish.ReplyCorrelationHeader)
+		// 다른 인스턴스가 보낸 요청의 응답도 함께 읽으므로, 기다리는 요청이 없으면 조용히 건너뛴다.
+		l.replies.Deliver(correlationID, internalpublish.Reply{Body: m.Value, Headers: metadata.Headers})
+
+		if err := l.fetcher.CommitMessages(context.Background(), m); err != nil {
+			log.Printf("[Kafka][Reply] Failed to commit reply: %v", err)
+		}
+	}
+}
+
+func newReplyGroupID() string {
+	var b [8]byte
+	_, _ = rand.Read(b[:])
+	return "spine-reply-" + hex.EncodeToString(b[:])
+}
@@ -0,0 +1,72 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	internalpublish "github.com/NARUBROWN/spine/internal/event/publish"
+	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+	"github.com/segmentio/kafka-go"
+)
+
+type replyTestEvent struct{}
+
+func (e replyTestEvent) Name() string          { return "quote.requested" }
+func (e replyTestEvent) OccurredAt() time.Time { return time.Unix(100, 0) }
+
+// replyingWriter는 요청을 기록하면 같은 correlation ID의 응답을 fetcher에 넣습니다.
+type replyingWriter struct {
+	fetcher *fakeDelayFetcher
+}
+
+func (w *replyingWriter) WriteMessages(ctx context.Context, msgs ...kafka.Message) error {
+	for _, m := range msgs {
+		metadata := messageMetadata(m)
+		w.fetcher.msgs <- replyMessage(metadata, metadata.Header(publish.ReplyToHeader), internalpublish.Reply{Body: []byte("quoted")})
+	}
+	return nil
+}
+
+func (w *replyingWriter) Close() error { return nil }
+
+func TestReplyListener_DeliversRepliesToWaitingRequests(t *testing.T) {
+	fetcher := &fakeDelayFetcher{msgs: make(chan kafka.Message, 2)}
+	replies := internalpublish.NewReplyRouter()
+	listener := &ReplyListener{fetcher: fetcher, replies: replies}
+	listener.Start(context.Background())
+	defer listener.Stop()
+
+	// 다른 인스턴스의 요청에 대한 응답은 건너뛰어야 한다.
+	fetcher.msgs <- kafka.Message{Offset: 0, Headers: []kafka.Header{{Key: publish.ReplyCorrelationHeader, Value: []byte("other")}}}
+
+	requester, err := internalpublish.NewRequester(
+		&KafkaPublisher{writer: &replyingWriter{fetcher: fetcher}},
+		"quotes.reply",
+		replies,
+	)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	reply, err := requester.Request(ctx, replyTestEvent{})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if string(reply) != "quoted" {
+		t.Fatalf("응답 Topic에서 받은 응답이어야 합니다: %s", reply)
+	}
+}
+
+func TestNewReplyListener_RequiresReplyTopic(t *testing.T) {
+	_, err := NewReplyListener(boot.KafkaOptions{
+		Brokers: []string{"localhost:9092"},
+		Write:   &boot.KafkaWriteOptions{},
+	}, internalpublish.NewReplyRouter())
+	if err == nil {
+		t.Fatal("응답 Topic이 없으면 에러가 발생해야 합니다")
+	}
+}
@@ -14,8 +14,17 @@ func NewRunnerFactory(opts boot.KafkaOptions) *RunnerFactory {
 }
 
 func (f *RunnerFactory) Build(registration consumer.Registration) (consumer.Reader, error) {
-	return NewKafkaReader(
+	reader, err := NewKafkaReader(
 		registration.Topic,
 		f.opts,
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	reader.SetOrdering(registration.Options.Ordering)
+	if retry := registration.Options.Retry; retry != nil && retry.DeadLetter != "" {
+		reader.EnableDeadLetter()
+	}
+	return reader, nil
 }
@@ -2,12 +2,15 @@ package kafka
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"maps"
+	"slices"
+	"time"
 
 	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/event/codec"
 	"github.com/NARUBROWN/spine/pkg/event/publish"
 	"github.com/segmentio/kafka-go"
 )
@@ -21,6 +24,10 @@ type KafkaPublisher struct {
 	Writer      *kafka.Writer
 	writer      kafkaMessageWriter
 	topicPrefix string
+	// 지연 발행 메시지를 보관할 Topic (비어 있으면 지연 발행 불가)
+	delayTopic string
+	// 이벤트 직렬화 형식 (nil이면 JSON)
+	codecs *codec.Registry
 }
 
 func NewKafkaPublisher(opts *boot.KafkaOptions) (*KafkaPublisher, error) {
@@ -45,20 +52,64 @@ func NewKafkaPublisher(opts *boot.KafkaOptions) (*KafkaPublisher, error) {
 		Writer:      writer,
 		writer:      writer,
 		topicPrefix: opts.Write.TopicPrefix,
+		delayTopic:  opts.Write.DelayTopic,
 	}, nil
 }
 
 func (p *KafkaPublisher) Publish(ctx context.Context, event publish.DomainEvent) error {
-	payload, err := json.Marshal(event)
+	msg, err := p.message(ctx, event)
 	if err != nil {
-		return fmt.Errorf("KafkaPublisher serialization failed: %w", err)
+		return err
+	}
+	return p.client().WriteMessages(ctx, msg)
+}
+
+/*
+PublishAt은 메시지를 지연 Topic에 기록하고, DelayRelay가 deliverAt 이후에 원래 Topic으로 옮깁니다.
+지연 Topic은 Kafka에 저장되므로 재시작 후에도 유지됩니다.
+*/
+func (p *KafkaPublisher) PublishAt(ctx context.Context, event publish.DomainEvent, deliverAt time.Time) error {
+	if p.delayTopic == "" {
+		return errors.New("Kafka delayed publishing requires KafkaWriteOptions.DelayTopic")
 	}
 
-	return p.client().WriteMessages(ctx, kafka.Message{
+	msg, err := p.message(ctx, event)
+	if err != nil {
+		return err
+	}
+	return p.client().WriteMessages(ctx, delayedMessage(msg, p.delayTopic, deliverAt))
+}
+
+func (p *KafkaPublisher) message(ctx context.Context, event publish.DomainEvent) (kafka.Message, error) {
+	// Codec(ce-id)과 X-Message-Id 헤더가 같은 ID를 쓰도록 ID를 한 번만 정한다.
+	ctx = publish.ContextWithMessageID(ctx, publish.MessageID(ctx, event))
+	encoded, err := p.codecs.Encode(ctx, event)
+	if err != nil {
+		return kafka.Message{}, fmt.Errorf("KafkaPublisher serialization failed: %w", err)
+	}
+
+	msg := kafka.Message{
 		Topic: p.topicName(event.Name()),
-		Value: payload,
+		Value: encoded.Body,
 		Time:  event.OccurredAt(),
-	})
+	}
+	if key := publish.MessageKey(event); key != "" {
+		msg.Key = []byte(key)
+	}
+
+	// Codec 헤더(content-type, ce-*)는 형식을 결정하므로 이벤트 헤더보다 우선한다.
+	headers := publish.MessageHeaders(ctx, event)
+	if headers == nil {
+		headers = make(map[string]string, len(encoded.Headers))
+	}
+	maps.Copy(headers, encoded.Headers)
+	msg.Headers = kafkaHeaders(headers)
+	return msg, nil
+}
+
+// SetCodecs는 이벤트를 직렬화할 Codec Registry를 지정합니다.
+func (p *KafkaPublisher) SetCodecs(codecs *codec.Registry) {
+	p.codecs = codecs
 }
 
 func (p *KafkaPublisher) Close() error {
@@ -85,3 +136,16 @@ func (p *KafkaPublisher) topicName(eventName string) string {
 	}
 	return p.topicPrefix + eventName
 }
+
+// kafkaHeaders는 헤더를 이름순으로 정렬해 Kafka 헤더 목록으로 바꿉니다.
+func kafkaHeaders(headers map[string]string) []kafka.Header {
+	if len(headers) == 0 {
+		return nil
+	}
+
+	result := make([]kafka.Header, 0, len(headers))
+	for _, key := range slices.Sorted(maps.Keys(headers)) {
+		result = append(result, kafka.Header{Key: key, Value: []byte(headers[key])})
+	}
+	return result
+}
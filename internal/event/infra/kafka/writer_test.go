@@ -2,10 +2,12 @@ package kafka
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/event/codec"
 	eventpublish "github.com/NARUBROWN/spine/pkg/event/publish"
 	"github.com/segmentio/kafka-go"
 )
@@ -53,6 +55,82 @@ func TestKafkaPublisher_PublishUsesTopicPrefix(t *testing.T) {
 	}
 }
 
+type keyedDomainEvent struct {
+	fakeDomainEvent
+}
+
+func (e keyedDomainEvent) Key() string     { return "order-1" }
+func (e keyedDomainEvent) EventID() string { return "evt-1" }
+func (e keyedDomainEvent) Headers() map[string]string {
+	return map[string]string{"X-Tenant": "acme", "X-Correlation-Id": "event-wins"}
+}
+
+func TestKafkaPublisher_PublishSetsKeyAndHeaders(t *testing.T) {
+	writer := &fakeKafkaWriter{}
+	publisher := &KafkaPublisher{writer: writer}
+
+	ctx := eventpublish.ContextWithHeaders(context.Background(), map[string]string{
+		"X-Correlation-Id": "corr-1",
+		"traceparent":      "00-abc-def-01",
+	})
+	if err := publisher.Publish(ctx, keyedDomainEvent{fakeDomainEvent{name: "orders.created", at: time.Now()}}); err != nil {
+		t.Fatalf("Publish 실패: %v", err)
+	}
+
+	msg := writer.messages[0]
+	if string(msg.Key) != "order-1" {
+		t.Fatalf("Keyed 이벤트의 key가 반영되어야 합니다: %q", msg.Key)
+	}
+
+	var got []string
+	for _, h := range msg.Headers {
+		got = append(got, h.Key+"="+string(h.Value))
+	}
+	if strings.Join(got, ",") != "X-Correlation-Id=event-wins,X-Message-Id=evt-1,X-Tenant=acme,content-type=application/json,traceparent=00-abc-def-01" {
+		t.Fatalf("헤더가 잘못되었습니다: %v", got)
+	}
+}
+
+func TestKafkaPublisher_PublishUsesCodecHeaders(t *testing.T) {
+	writer := &fakeKafkaWriter{}
+	publisher := &KafkaPublisher{writer: writer}
+	publisher.SetCodecs(codec.NewRegistry(codec.CloudEvents{Mode: codec.BinaryMode, Source: "/orders"}))
+
+	if err := publisher.Publish(context.Background(), keyedDomainEvent{fakeDomainEvent{name: "orders.created", at: time.Now()}}); err != nil {
+		t.Fatalf("Publish 실패: %v", err)
+	}
+
+	headers := make(map[string]string)
+	for _, h := range writer.messages[0].Headers {
+		headers[h.Key] = string(h.Value)
+	}
+	if headers["ce-type"] != "orders.created" || headers["ce-source"] != "/orders" || headers["content-type"] != "application/json" {
+		t.Fatalf("Codec 헤더가 메시지 헤더로 전달되어야 합니다: %v", headers)
+	}
+	if headers["X-Tenant"] != "acme" {
+		t.Fatalf("이벤트 헤더도 유지되어야 합니다: %v", headers)
+	}
+}
+
+func TestKafkaPublisher_PublishUsesSameIDForCloudEventsAndMessageID(t *testing.T) {
+	writer := &fakeKafkaWriter{}
+	publisher := &KafkaPublisher{writer: writer}
+	publisher.SetCodecs(codec.NewRegistry(codec.CloudEvents{Mode: codec.BinaryMode, Source: "/orders"}))
+
+	// ID를 지정하지 않은 이벤트도 ce-id와 X-Message-Id가 같아야 한다.
+	if err := publisher.Publish(context.Background(), fakeDomainEvent{name: "orders.created", at: time.Now()}); err != nil {
+		t.Fatalf("Publish 실패: %v", err)
+	}
+
+	headers := make(map[string]string)
+	for _, h := range writer.messages[0].Headers {
+		headers[h.Key] = string(h.Value)
+	}
+	if headers["ce-id"] == "" || headers["ce-id"] != headers[eventpublish.MessageIDHeader] {
+		t.Fatalf("ce-id와 X-Message-Id는 같은 ID여야 합니다: %v", headers)
+	}
+}
+
 func TestNewKafkaPublisher_RequiresWriteOptions(t *testing.T) {
 	_, err := NewKafkaPublisher(&boot.KafkaOptions{
 		Brokers: []string{"localhost:9092"},
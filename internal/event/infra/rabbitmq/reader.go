@@ -3,8 +3,14 @@ package rabbitmq
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/NARUBROWN/spine/internal/event/consumer"
+	internalpublish "github.com/NARUBROWN/spine/internal/event/publish"
+	"github.com/NARUBROWN/spine/pkg/event"
+	"github.com/NARUBROWN/spine/pkg/event/codec"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
 	"github.com/rabbitmq/amqp091-go"
 )
 
@@ -12,6 +18,14 @@ type Reader struct {
 	conn    *amqp091.Connection
 	channel *amqp091.Channel
 	msgs    <-chan amqp091.Delivery
+	// Dead Letter 큐로 메시지를 보내는 publisher (정책이 없으면 nil)
+	deadLetterPublisher amqpPublisher
+	// 요청 메시지의 응답을 보내는 publisher
+	replyPublisher amqpPublisher
+}
+
+type amqpPublisher interface {
+	PublishWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp091.Publishing) error
 }
 
 type RabbitMqOptions struct {
@@ -23,6 +37,10 @@ type RabbitMqReadOptions struct {
 	Queue      string
 	Exchange   string
 	RoutingKey string
+	// 비어 있지 않으면 이 이름의 큐를 선언하고 Dead Letter 전송에 사용한다.
+	DeadLetterQueue string
+	// 0보다 크면 채널 Qos로 ACK 전에 받을 수 있는 메시지 수를 제한한다.
+	Prefetch int
 }
 
 func NewRabbitMqReader(opts RabbitMqOptions) (*Reader, error) {
@@ -86,6 +104,30 @@ func NewRabbitMqReader(opts RabbitMqOptions) (*Reader, error) {
 		return nil, err
 	}
 
+	if opts.Read.DeadLetterQueue != "" {
+		_, err = ch.QueueDeclare(
+			opts.Read.DeadLetterQueue,
+			true,  // durable
+			false, // auto-delete
+			false, // exclusive
+			false, // no-wait
+			nil,
+		)
+		if err != nil {
+			_ = ch.Close()
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+
+	if opts.Read.Prefetch > 0 {
+		if err := ch.Qos(opts.Read.Prefetch, 0, false); err != nil {
+			_ = ch.Close()
+			_ = conn.Close()
+			return nil, err
+		}
+	}
+
 	msgs, err := ch.Consume(
 		opts.Read.Queue,
 		"",
@@ -101,11 +143,16 @@ func NewRabbitMqReader(opts RabbitMqOptions) (*Reader, error) {
 		return nil, err
 	}
 
-	return &Reader{
-		conn:    conn,
-		channel: ch,
-		msgs:    msgs,
-	}, nil
+	reader := &Reader{
+		conn:           conn,
+		channel:        ch,
+		msgs:           msgs,
+		replyPublisher: ch,
+	}
+	if opts.Read.DeadLetterQueue != "" {
+		reader.deadLetterPublisher = ch
+	}
+	return reader, nil
 }
 
 func (r *Reader) Read(ctx context.Context) (*consumer.Message, error) {
@@ -123,9 +170,7 @@ func (r *Reader) Read(ctx context.Context) (*consumer.Message, error) {
 		consumerMsg := &consumer.Message{
 			EventName: eventName,
 			Payload:   msg.Body,
-			Metadata: map[string]string{
-				"routing_key": msg.RoutingKey,
-			},
+			Metadata:  deliveryMetadata(msg),
 		}
 
 		// ACK 콜백 설정: 핸들러 성공 시 ACK
@@ -133,6 +178,26 @@ func (r *Reader) Read(ctx context.Context) (*consumer.Message, error) {
 			return msg.Ack(false)
 		})
 
+		// Reject 콜백 설정: 재처리하지 않을 메시지는 requeue 없이 NACK (큐에 DLX가 있으면 그쪽으로 이동)
+		consumerMsg.SetRejectHandler(func() error {
+			return msg.Nack(false, false)
+		})
+
+		// Dead Letter 콜백 설정: 기본 exchange를 통해 Dead Letter 큐로 직접 전송
+		consumerMsg.SetDeadLetterHandler(func(ctx context.Context, letter consumer.DeadLetter) error {
+			if r.deadLetterPublisher == nil {
+				return errors.New("RabbitMQ dead letter queue is not configured")
+			}
+			return r.deadLetterPublisher.PublishWithContext(ctx, "", letter.Destination, false, false, deadLetterPublishing(msg, letter))
+		})
+
+		// Reply 콜백 설정: 요청 메시지면 기본 exchange를 통해 ReplyTo 큐로 응답
+		if msg.ReplyTo != "" {
+			consumerMsg.SetReplyHandler(func(ctx context.Context, reply internalpublish.Reply) error {
+				return r.replyPublisher.PublishWithContext(ctx, "", msg.ReplyTo, false, false, replyPublishing(msg, reply))
+			})
+		}
+
 		// NACK 콜백 설정: 핸들러 실패 시 NACK (requeue=true)
 		consumerMsg.SetNackHandler(func() error {
 			return msg.Nack(false, true) // multiple=false, requeue=true
@@ -142,6 +207,63 @@ func (r *Reader) Read(ctx context.Context) (*consumer.Message, error) {
 	}
 }
 
+func deliveryMetadata(msg amqp091.Delivery) event.Metadata {
+	headers := make(map[string]string, len(msg.Headers))
+	for key, value := range msg.Headers {
+		headers[key] = fmt.Sprint(value)
+	}
+	// Consumer가 Codec을 고를 수 있도록 AMQP content-type 속성을 헤더로도 노출한다.
+	if _, ok := headers[codec.ContentTypeHeader]; !ok && msg.ContentType != "" {
+		headers[codec.ContentTypeHeader] = msg.ContentType
+	}
+
+	return event.Metadata{
+		Headers:       headers,
+		Timestamp:     msg.Timestamp,
+		MessageID:     msg.MessageId,
+		CorrelationID: msg.CorrelationId,
+		RoutingKey:    msg.RoutingKey,
+		Redelivered:   msg.Redelivered,
+	}
+}
+
+func deadLetterPublishing(msg amqp091.Delivery, letter consumer.DeadLetter) amqp091.Publishing {
+	headers := amqp091.Table{}
+	for key, value := range msg.Headers {
+		headers[key] = value
+	}
+	headers[consumer.DeadLetterErrorHeader] = letter.Err.Error()
+	headers[consumer.DeadLetterAttemptsHeader] = int32(letter.Attempts)
+	headers[consumer.DeadLetterOriginTopicHeader] = msg.RoutingKey
+
+	return amqp091.Publishing{
+		Headers:       headers,
+		ContentType:   msg.ContentType,
+		DeliveryMode:  amqp091.Persistent,
+		CorrelationId: msg.CorrelationId,
+		MessageId:     msg.MessageId,
+		Timestamp:     msg.Timestamp,
+		Type:          msg.Type,
+		Body:          msg.Body,
+	}
+}
+
+func replyPublishing(msg amqp091.Delivery, reply internalpublish.Reply) amqp091.Publishing {
+	headers := make(amqp091.Table, len(reply.Headers)+1)
+	for key, value := range reply.Headers {
+		headers[key] = value
+	}
+	headers[publish.ReplyCorrelationHeader] = msg.CorrelationId
+
+	return amqp091.Publishing{
+		Headers:       headers,
+		ContentType:   reply.Headers[codec.ContentTypeHeader],
+		CorrelationId: msg.CorrelationId,
+		Timestamp:     time.Now(),
+		Body:          reply.Body,
+	}
+}
+
 func (r *Reader) Close() error {
 	if r.channel != nil {
 		_ = r.channel.Close()
@@ -2,9 +2,12 @@ package rabbitmq
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 
+	"github.com/NARUBROWN/spine/internal/event/consumer"
+	internalpublish "github.com/NARUBROWN/spine/internal/event/publish"
 	"github.com/rabbitmq/amqp091-go"
 )
 
@@ -72,11 +75,15 @@ func TestReader_ReadBuildsMessageAndAckNack(t *testing.T) {
 	msgs := make(chan amqp091.Delivery, 1)
 	ack := &fakeAcknowledger{}
 	msgs <- amqp091.Delivery{
-		Acknowledger: ack,
-		DeliveryTag:  7,
-		Type:         "order.created",
-		Body:         []byte(`{"id":1}`),
-		RoutingKey:   "orders.created",
+		Acknowledger:  ack,
+		DeliveryTag:   7,
+		Type:          "order.created",
+		Body:          []byte(`{"id":1}`),
+		RoutingKey:    "orders.created",
+		MessageId:     "m-1",
+		CorrelationId: "c-1",
+		Redelivered:   true,
+		Headers:       amqp091.Table{"X-Trace-Id": "t-1", "retry": int32(2)},
 	}
 
 	reader := &Reader{msgs: msgs}
@@ -91,8 +98,12 @@ func TestReader_ReadBuildsMessageAndAckNack(t *testing.T) {
 	if string(msg.Payload) != `{"id":1}` {
 		t.Fatalf("payload가 잘못되었습니다: %s", string(msg.Payload))
 	}
-	if msg.Metadata["routing_key"] != "orders.created" {
-		t.Fatalf("metadata가 잘못되었습니다: %+v", msg.Metadata)
+	meta := msg.Metadata
+	if meta.RoutingKey != "orders.created" || meta.MessageID != "m-1" || meta.CorrelationID != "c-1" || !meta.Redelivered {
+		t.Fatalf("metadata가 잘못되었습니다: %+v", meta)
+	}
+	if meta.Header("x-trace-id") != "t-1" || meta.Headers["retry"] != "2" {
+		t.Fatalf("header metadata가 잘못되었습니다: %+v", meta.Headers)
 	}
 
 	if err := msg.Ack(); err != nil {
@@ -110,6 +121,108 @@ func TestReader_ReadBuildsMessageAndAckNack(t *testing.T) {
 	}
 }
 
+type fakePublisher struct {
+	exchange string
+	key      string
+	msg      amqp091.Publishing
+}
+
+func (p *fakePublisher) PublishWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp091.Publishing) error {
+	p.exchange = exchange
+	p.key = key
+	p.msg = msg
+	return nil
+}
+
+func TestReader_RejectAndDeadLetter(t *testing.T) {
+	msgs := make(chan amqp091.Delivery, 1)
+	ack := &fakeAcknowledger{}
+	msgs <- amqp091.Delivery{
+		Acknowledger: ack,
+		DeliveryTag:  9,
+		Type:         "order.created",
+		Body:         []byte(`{"id":1}`),
+		RoutingKey:   "order.created",
+		Headers:      amqp091.Table{"trace": "abc"},
+	}
+
+	publisher := &fakePublisher{}
+	reader := &Reader{msgs: msgs, deadLetterPublisher: publisher}
+	msg, err := reader.Read(context.Background())
+	if err != nil {
+		t.Fatalf("Read 실패: %v", err)
+	}
+
+	if err := msg.Reject(); err != nil {
+		t.Fatalf("Reject 실패: %v", err)
+	}
+	if !ack.nackCalled || ack.nackRequeue {
+		t.Fatalf("Reject는 requeue 없이 NACK 해야 합니다: %+v", ack)
+	}
+
+	err = msg.SendToDeadLetter(context.Background(), consumer.DeadLetter{
+		Destination: "order.created.dlq",
+		Err:         errors.New("boom"),
+		Attempts:    3,
+	})
+	if err != nil {
+		t.Fatalf("Dead Letter 전송 실패: %v", err)
+	}
+	if publisher.exchange != "" || publisher.key != "order.created.dlq" {
+		t.Fatalf("Dead Letter 큐로 직접 보내야 합니다: exchange=%q key=%q", publisher.exchange, publisher.key)
+	}
+	headers := publisher.msg.Headers
+	if headers["trace"] != "abc" || headers[consumer.DeadLetterErrorHeader] != "boom" || headers[consumer.DeadLetterAttemptsHeader] != int32(3) {
+		t.Fatalf("Dead Letter 헤더가 잘못되었습니다: %+v", headers)
+	}
+	if string(publisher.msg.Body) != `{"id":1}` || publisher.msg.Type != "order.created" {
+		t.Fatalf("원본 메시지가 보존되어야 합니다: %+v", publisher.msg)
+	}
+}
+
+func TestReader_ReplyPublishesToReplyQueue(t *testing.T) {
+	msgs := make(chan amqp091.Delivery, 2)
+	msgs <- amqp091.Delivery{
+		Type:          "quote.requested",
+		Body:          []byte(`{"sku":"a"}`),
+		ReplyTo:       DirectReplyTo,
+		CorrelationId: "req-1",
+	}
+	msgs <- amqp091.Delivery{Type: "order.created"}
+
+	publisher := &fakePublisher{}
+	reader := &Reader{msgs: msgs, replyPublisher: publisher}
+	msg, err := reader.Read(context.Background())
+	if err != nil {
+		t.Fatalf("Read 실패: %v", err)
+	}
+	if !msg.IsRequest() {
+		t.Fatal("ReplyTo가 있는 메시지는 요청이어야 합니다")
+	}
+
+	err = msg.Reply(context.Background(), internalpublish.Reply{
+		Body:    []byte(`{"price":100}`),
+		Headers: map[string]string{"content-type": "application/json"},
+	})
+	if err != nil {
+		t.Fatalf("응답 전송 실패: %v", err)
+	}
+	if publisher.exchange != "" || publisher.key != DirectReplyTo {
+		t.Fatalf("ReplyTo 큐로 직접 보내야 합니다: exchange=%q key=%q", publisher.exchange, publisher.key)
+	}
+	if publisher.msg.CorrelationId != "req-1" || publisher.msg.ContentType != "application/json" || string(publisher.msg.Body) != `{"price":100}` {
+		t.Fatalf("응답 메시지가 잘못되었습니다: %+v", publisher.msg)
+	}
+
+	plain, err := reader.Read(context.Background())
+	if err != nil {
+		t.Fatalf("Read 실패: %v", err)
+	}
+	if plain.IsRequest() {
+		t.Fatal("ReplyTo가 없는 메시지는 요청이 아닙니다")
+	}
+}
+
 func TestReaderAndWriter_CloseNilSafe(t *testing.T) {
 	if err := (&Reader{}).Close(); err != nil {
 		t.Fatalf("Reader.Close는 nil-safe 해야 합니다: %v", err)
@@ -14,12 +14,19 @@ func NewRunnerFactory(opts boot.RabbitMqOptions) *RunnerFactory {
 }
 
 func (f *RunnerFactory) Build(registration consumer.Registration) (consumer.Reader, error) {
+	var deadLetterQueue string
+	if retry := registration.Options.Retry; retry != nil {
+		deadLetterQueue = retry.DeadLetter
+	}
+
 	return NewRabbitMqReader(RabbitMqOptions{
 		URL: f.opts.URL,
 		Read: &RabbitMqReadOptions{
-			Queue:      registration.Topic,
-			Exchange:   f.opts.Read.Exchange,
-			RoutingKey: registration.Topic,
+			Queue:           registration.Topic,
+			Exchange:        f.opts.Read.Exchange,
+			RoutingKey:      registration.Topic,
+			DeadLetterQueue: deadLetterQueue,
+			Prefetch:        registration.Options.Concurrency,
 		},
 	})
 }
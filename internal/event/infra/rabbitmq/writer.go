@@ -2,12 +2,15 @@ package rabbitmq
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"strconv"
+	"time"
 
+	internalpublish "github.com/NARUBROWN/spine/internal/event/publish"
 	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/event/codec"
 	"github.com/NARUBROWN/spine/pkg/event/publish"
 	"github.com/rabbitmq/amqp091-go"
 )
@@ -16,6 +19,8 @@ type Writer struct {
 	conn     *amqp091.Connection
 	channel  *amqp091.Channel
 	exchange string
+	// 이벤트 직렬화 형식 (nil이면 JSON)
+	codecs *codec.Registry
 }
 
 func NewRabbitMqWriter(opts boot.RabbitMqOptions) (*Writer, error) {
@@ -60,8 +65,7 @@ func NewRabbitMqWriter(opts boot.RabbitMqOptions) (*Writer, error) {
 }
 
 func (w *Writer) Publish(ctx context.Context, event publish.DomainEvent) error {
-
-	payload, err := json.Marshal(event)
+	msg, err := newPublishing(ctx, event, w.codecs)
 	if err != nil {
 		return err
 	}
@@ -72,13 +76,146 @@ func (w *Writer) Publish(ctx context.Context, event publish.DomainEvent) error {
 		event.Name(),
 		false,
 		false,
-		amqp091.Publishing{
-			ContentType: "application/json",
-			Body:        payload,
-			Timestamp:   event.OccurredAt(),
-			Type:        event.Name(),
-		},
+		msg,
+	)
+}
+
+/*
+PublishAt은 deliverAt 이후에 Exchange로 전달되도록 이벤트를 발행합니다. (TTL + Dead Letter Exchange)
+
+메시지는 먼저 이벤트와 지연 시간(초 단위로 올림)마다 만드는 대기 큐에 들어가고,
+큐 TTL이 지나면 원래 Exchange로 이벤트 이름을 routing key로 하여 dead-letter 됩니다.
+대기 큐는 RabbitMQ에 저장되므로 재시작 후에도 유지되며, 쓰이지 않으면 자동으로 삭제됩니다.
+*/
+func (w *Writer) PublishAt(ctx context.Context, event publish.DomainEvent, deliverAt time.Time) error {
+	msg, err := newPublishing(ctx, event, w.codecs)
+	if err != nil {
+		return err
+	}
+
+	queue, args := delayQueue(w.exchange, event.Name(), time.Until(deliverAt))
+	if _, err := w.channel.QueueDeclare(
+		queue,
+		true,  // durable
+		false, // auto-delete (x-expires로 정리)
+		false, // exclusive
+		false, // no-wait
+		args,
+	); err != nil {
+		return fmt.Errorf("RabbitMQ delay queue declaration failed: %w", err)
+	}
+
+	msg.DeliveryMode = amqp091.Persistent
+	return w.channel.PublishWithContext(
+		ctx,
+		"", // default exchange: routing key가 곧 큐 이름
+		queue,
+		false,
+		false,
+		msg,
+	)
+}
+
+// delayQueue는 지연 전달에 사용할 대기 큐 이름과 인자를 반환합니다.
+func delayQueue(exchange, eventName string, delay time.Duration) (string, amqp091.Table) {
+	seconds := int64((max(delay, 0) + time.Second - 1) / time.Second)
+	ttl := seconds * 1000
+
+	name := fmt.Sprintf("spine.delay.%s.%s.%ds", exchange, eventName, seconds)
+	return name, amqp091.Table{
+		"x-message-ttl":             ttl,
+		"x-dead-letter-exchange":    exchange,
+		"x-dead-letter-routing-key": eventName,
+		// 마지막 선언 후 TTL이 지나 메시지가 모두 빠지면 큐를 정리한다.
+		"x-expires": ttl + time.Minute.Milliseconds(),
+	}
+}
+
+/*
+newPublishing은 이벤트를 AMQP 메시지로 바꿉니다.
+content-type, correlation ID, message ID 헤더는 AMQP 속성으로도 설정합니다.
+*/
+func newPublishing(ctx context.Context, event publish.DomainEvent, codecs *codec.Registry) (amqp091.Publishing, error) {
+	// Codec(ce-id)과 X-Message-Id 헤더가 같은 ID를 쓰도록 ID를 한 번만 정한다.
+	ctx = publish.ContextWithMessageID(ctx, publish.MessageID(ctx, event))
+	encoded, err := codecs.Encode(ctx, event)
+	if err != nil {
+		return amqp091.Publishing{}, err
+	}
+
+	msg := amqp091.Publishing{
+		ContentType: encoded.Header(codec.ContentTypeHeader),
+		Body:        encoded.Body,
+		Timestamp:   event.OccurredAt(),
+		Type:        event.Name(),
+	}
+
+	// Codec 헤더(content-type, ce-*)는 형식을 결정하므로 이벤트 헤더보다 우선한다.
+	headers := publish.MessageHeaders(ctx, event)
+	for key, value := range encoded.Headers {
+		if key == codec.ContentTypeHeader {
+			continue
+		}
+		if headers == nil {
+			headers = make(map[string]string, len(encoded.Headers))
+		}
+		headers[key] = value
+	}
+	if len(headers) > 0 {
+		msg.Headers = make(amqp091.Table, len(headers))
+		for key, value := range headers {
+			msg.Headers[key] = value
+		}
+		msg.CorrelationId = headers[publish.CorrelationIDHeader]
+		msg.MessageId = headers[publish.MessageIDHeader]
+	}
+
+	// 요청 메시지는 AMQP RPC 규약대로 ReplyTo/CorrelationId를 설정하고, 마감 시각이 지나면 큐에서 버려지게 한다.
+	if replyTo := headers[publish.ReplyToHeader]; replyTo != "" {
+		msg.ReplyTo = replyTo
+		msg.CorrelationId = headers[publish.ReplyCorrelationHeader]
+		if deadline, err := time.Parse(time.RFC3339Nano, headers[publish.RequestDeadlineHeader]); err == nil {
+			msg.Expiration = strconv.FormatInt(max(time.Until(deadline).Milliseconds(), 1), 10)
+		}
+	}
+	return msg, nil
+}
+
+// DirectReplyTo는 응답을 받을 큐를 따로 만들지 않는 RabbitMQ Direct Reply-to 주소입니다.
+const DirectReplyTo = "amq.rabbitmq.reply-to"
+
+/*
+ConsumeReplies는 이 Writer의 채널에서 Direct Reply-to 응답을 받아 기다리는 요청에 전달합니다.
+Direct Reply-to는 요청을 발행한 채널로만 응답이 오므로, 요청은 이 Writer로 발행해야 합니다.
+*/
+func (w *Writer) ConsumeReplies(replies *internalpublish.ReplyRouter) error {
+	deliveries, err := w.channel.Consume(
+		DirectReplyTo,
+		"",
+		true, // Direct Reply-to는 auto-ack만 지원한다.
+		false,
+		false,
+		false,
+		nil,
 	)
+	if err != nil {
+		return fmt.Errorf("RabbitMQ reply consumer creation failed: %w", err)
+	}
+
+	go func() {
+		for delivery := range deliveries {
+			reply := internalpublish.Reply{Body: delivery.Body, Headers: deliveryMetadata(delivery).Headers}
+			if !replies.Deliver(delivery.CorrelationId, reply) {
+				log.Printf("[RabbitMQ][Reply] Discarding reply with no waiting request (correlation=%s)", delivery.CorrelationId)
+			}
+		}
+	}()
+	return nil
+}
+
+// SetCodecs는 이벤트를 직렬화할 Codec Registry를 지정합니다.
+func (w *Writer) SetCodecs(codecs *codec.Registry) {
+	w.codecs = codecs
 }
 
 func (w *Writer) Close() error {
@@ -0,0 +1,188 @@
+package outbox
+
+import (
+	"context"
+	"log"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	eventPublish "github.com/NARUBROWN/spine/internal/event/publish"
+	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/event/outbox"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+const (
+	defaultPollInterval    = time.Second
+	defaultBatchSize       = 100
+	defaultRetention       = 24 * time.Hour
+	defaultCleanupInterval = time.Minute
+)
+
+/*
+Relay는 Outbox의 미발행 레코드를 주기적으로 읽어 Dispatcher로 발행합니다.
+
+  - 발행에 성공한 레코드만 발행 완료로 표시하므로, 실패하거나 표시 전에 종료되면 다시 발행됩니다. (최소 한 번 전달)
+  - 레코드는 ID 순서로 발행하며, 어떤 aggregate의 이벤트가 실패하면 같은 aggregate의 뒤 이벤트는 다음 주기로 미룹니다.
+    같은 주기의 다음 배치는 실패한 aggregate를 제외하고 읽으므로, 다른 aggregate의 발행이 밀리지 않습니다.
+  - 보관 기간이 지난 발행 완료 레코드는 주기적으로 삭제합니다.
+
+레코드를 점유하지 않으므로 같은 Store에 대해 relay는 하나만 실행해야 합니다.
+*/
+type Relay struct {
+	store      outbox.Store
+	dispatcher eventPublish.EventDispatcher
+
+	pollInterval    time.Duration
+	batchSize       int
+	retention       time.Duration
+	cleanupInterval time.Duration
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewRelay(store outbox.Store, dispatcher eventPublish.EventDispatcher, opts boot.OutboxOptions) *Relay {
+	r := &Relay{
+		store:           store,
+		dispatcher:      dispatcher,
+		pollInterval:    opts.PollInterval,
+		batchSize:       opts.BatchSize,
+		retention:       opts.Retention,
+		cleanupInterval: opts.CleanupInterval,
+	}
+
+	if r.pollInterval <= 0 {
+		r.pollInterval = defaultPollInterval
+	}
+	if r.batchSize <= 0 {
+		r.batchSize = defaultBatchSize
+	}
+	if r.retention == 0 {
+		r.retention = defaultRetention
+	}
+	if r.cleanupInterval <= 0 {
+		r.cleanupInterval = defaultCleanupInterval
+	}
+	return r
+}
+
+// Start는 relay를 백그라운드에서 시작합니다.
+func (r *Relay) Start(ctx context.Context) {
+	ctx, r.cancel = context.WithCancel(ctx)
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		r.run(ctx)
+	}()
+}
+
+// Stop은 relay를 멈추고 진행 중인 발행이 끝날 때까지 기다립니다.
+func (r *Relay) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	r.wg.Wait()
+}
+
+func (r *Relay) run(ctx context.Context) {
+	poll := time.NewTicker(r.pollInterval)
+	defer poll.Stop()
+
+	var cleanup <-chan time.Time
+	if r.retention > 0 {
+		ticker := time.NewTicker(r.cleanupInterval)
+		defer ticker.Stop()
+		cleanup = ticker.C
+	}
+
+	for {
+		// 한 배치를 가득 채웠다면 남은 레코드가 있을 수 있으므로 바로 다음 배치를 읽는다.
+		// 실패한 aggregate는 이번 주기 동안 제외하고, 더 진행할 수 없으면 다음 주기를 기다린다.
+		blocked := make(map[string]struct{})
+		for {
+			before := len(blocked)
+			sent, full := r.relay(ctx, blocked)
+			if !full || (sent == 0 && len(blocked) == before) || ctx.Err() != nil {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-poll.C:
+		case <-cleanup:
+			r.Cleanup(ctx)
+		}
+	}
+}
+
+/*
+RelayOnce는 미발행 레코드 한 배치를 발행합니다.
+발행 완료로 표시한 개수와, 배치가 가득 찼는지 여부를 반환합니다.
+*/
+func (r *Relay) RelayOnce(ctx context.Context) (int, bool) {
+	return r.relay(ctx, make(map[string]struct{}))
+}
+
+// relay는 blocked에 있는 aggregate를 제외하고 한 배치를 발행하며, 발행에 실패한 aggregate를 blocked에 추가합니다.
+func (r *Relay) relay(ctx context.Context, blocked map[string]struct{}) (int, bool) {
+	records, err := r.store.Pending(ctx, r.batchSize, slices.Sorted(maps.Keys(blocked))...)
+	if err != nil {
+		log.Printf("[Outbox] Failed to read pending events: %v", err)
+		return 0, false
+	}
+
+	sent := make([]int64, 0, len(records))
+
+	for _, record := range records {
+		if ctx.Err() != nil {
+			break
+		}
+		if record.AggregateID != "" {
+			if _, ok := blocked[record.AggregateID]; ok {
+				continue
+			}
+		}
+
+		if err := r.dispatcher.Dispatch(ctx, []publish.DomainEvent{record.Event()}); err != nil {
+			log.Printf("[Outbox] Failed to relay event (%s, id=%d): %v", record.EventName, record.ID, err)
+			if record.AggregateID != "" {
+				blocked[record.AggregateID] = struct{}{}
+			}
+			continue
+		}
+		sent = append(sent, record.ID)
+	}
+
+	if len(sent) == 0 {
+		return 0, len(records) >= r.batchSize
+	}
+
+	// 발행은 이미 끝났으므로 종료 중이어도 완료 표시는 남긴다.
+	if err := r.store.MarkSent(context.WithoutCancel(ctx), sent...); err != nil {
+		log.Printf("[Outbox] Failed to mark events as sent: %v", err)
+		return 0, false
+	}
+	return len(sent), len(records) >= r.batchSize
+}
+
+// Cleanup은 보관 기간이 지난 발행 완료 레코드를 삭제합니다.
+func (r *Relay) Cleanup(ctx context.Context) {
+	if r.retention < 0 {
+		return
+	}
+
+	deleted, err := r.store.DeleteSent(ctx, time.Now().Add(-r.retention))
+	if err != nil {
+		log.Printf("[Outbox] Failed to delete sent events: %v", err)
+		return
+	}
+	if deleted > 0 {
+		log.Printf("[Outbox] Deleted sent events (%d)", deleted)
+	}
+}
@@ -0,0 +1,264 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/event/codec"
+	"github.com/NARUBROWN/spine/pkg/event/outbox"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type orderEvent struct {
+	OrderID string `json:"orderId"`
+	Step    string `json:"step"`
+}
+
+func (e orderEvent) Name() string          { return "order." + e.Step }
+func (e orderEvent) OccurredAt() time.Time { return time.Unix(100, 0) }
+func (e orderEvent) AggregateID() string   { return e.OrderID }
+
+// protoOrderEvent는 proto.Message를 구현하는 도메인 이벤트입니다.
+type protoOrderEvent struct {
+	*wrapperspb.StringValue
+}
+
+func (e protoOrderEvent) Name() string          { return "order.tagged" }
+func (e protoOrderEvent) OccurredAt() time.Time { return time.Unix(100, 0) }
+func (e protoOrderEvent) AggregateID() string   { return "o-1" }
+
+type recordingDispatcher struct {
+	mu     sync.Mutex
+	events []publish.DomainEvent
+	fail   func(publish.DomainEvent) error
+}
+
+func (d *recordingDispatcher) Dispatch(ctx context.Context, events []publish.DomainEvent) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	for _, event := range events {
+		if d.fail != nil {
+			if err := d.fail(event); err != nil {
+				return err
+			}
+		}
+		d.events = append(d.events, event)
+	}
+	return nil
+}
+
+func (d *recordingDispatcher) names() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	names := make([]string, len(d.events))
+	for i, event := range d.events {
+		names[i] = publish.MessageKey(event) + ":" + event.Name()
+	}
+	return names
+}
+
+func appendEvents(t *testing.T, store outbox.Store, events ...publish.DomainEvent) {
+	t.Helper()
+	if err := outbox.Append(context.Background(), store, events...); err != nil {
+		t.Fatalf("Outbox 기록에 실패했습니다: %v", err)
+	}
+}
+
+func TestRelay_PublishesInOrderAndMarksSent(t *testing.T) {
+	store := outbox.NewMemoryStore()
+	appendEvents(t, store,
+		orderEvent{OrderID: "o-1", Step: "created"},
+		orderEvent{OrderID: "o-2", Step: "created"},
+		orderEvent{OrderID: "o-1", Step: "paid"},
+	)
+
+	dispatcher := &recordingDispatcher{}
+	relay := NewRelay(store, dispatcher, boot.OutboxOptions{})
+
+	sent, full := relay.RelayOnce(context.Background())
+	if sent != 3 || full {
+		t.Fatalf("세 건 모두 발행되어야 합니다: sent=%d, full=%v", sent, full)
+	}
+
+	want := []string{"o-1:order.created", "o-2:order.created", "o-1:order.paid"}
+	if got := dispatcher.names(); len(got) != len(want) || got[0] != want[0] || got[1] != want[1] || got[2] != want[2] {
+		t.Fatalf("기록된 순서대로 발행되어야 합니다: %v", got)
+	}
+
+	msg, err := codec.NewRegistry(nil).Encode(context.Background(), dispatcher.events[0])
+	if err != nil || string(msg.Body) != `{"orderId":"o-1","step":"created"}` || msg.Header(codec.ContentTypeHeader) != "application/json" {
+		t.Fatalf("기록된 본문이 그대로 발행되어야 합니다: %s %v (%v)", msg.Body, msg.Headers, err)
+	}
+
+	pending, _ := store.Pending(context.Background(), 0)
+	if len(pending) != 0 {
+		t.Fatalf("발행된 레코드는 완료로 표시되어야 합니다: %v", pending)
+	}
+}
+
+func TestRelay_HoldsBackLaterEventsOfFailedAggregate(t *testing.T) {
+	store := outbox.NewMemoryStore()
+	appendEvents(t, store,
+		orderEvent{OrderID: "o-1", Step: "created"},
+		orderEvent{OrderID: "o-2", Step: "created"},
+		orderEvent{OrderID: "o-1", Step: "paid"},
+	)
+
+	brokerDown := true
+	dispatcher := &recordingDispatcher{fail: func(event publish.DomainEvent) error {
+		if brokerDown && publish.MessageKey(event) == "o-1" {
+			return errors.New("broker unavailable")
+		}
+		return nil
+	}}
+	relay := NewRelay(store, dispatcher, boot.OutboxOptions{})
+
+	if sent, _ := relay.RelayOnce(context.Background()); sent != 1 {
+		t.Fatalf("실패하지 않은 aggregate만 발행되어야 합니다: %d", sent)
+	}
+	if got := dispatcher.names(); len(got) != 1 || got[0] != "o-2:order.created" {
+		t.Fatalf("실패한 aggregate의 뒤 이벤트는 보류되어야 합니다: %v", got)
+	}
+
+	brokerDown = false
+	if sent, _ := relay.RelayOnce(context.Background()); sent != 2 {
+		t.Fatalf("보류된 이벤트는 다음 주기에 발행되어야 합니다: %d", sent)
+	}
+	got := dispatcher.names()
+	if len(got) != 3 || got[1] != "o-1:order.created" || got[2] != "o-1:order.paid" {
+		t.Fatalf("재시도 시에도 aggregate 순서가 유지되어야 합니다: %v", got)
+	}
+}
+
+func TestRelay_NextBatchSkipsBlockedAggregates(t *testing.T) {
+	store := outbox.NewMemoryStore()
+	appendEvents(t, store,
+		orderEvent{OrderID: "o-1", Step: "created"},
+		orderEvent{OrderID: "o-1", Step: "paid"},
+		orderEvent{OrderID: "o-2", Step: "created"},
+	)
+
+	dispatcher := &recordingDispatcher{fail: func(event publish.DomainEvent) error {
+		if publish.MessageKey(event) == "o-1" {
+			return errors.New("broker unavailable")
+		}
+		return nil
+	}}
+	relay := NewRelay(store, dispatcher, boot.OutboxOptions{BatchSize: 2})
+
+	blocked := make(map[string]struct{})
+	if sent, full := relay.relay(context.Background(), blocked); sent != 0 || !full {
+		t.Fatalf("실패한 aggregate로 가득 찬 배치입니다: sent=%d full=%v", sent, full)
+	}
+	if sent, _ := relay.relay(context.Background(), blocked); sent != 1 {
+		t.Fatalf("다음 배치는 실패한 aggregate를 제외하고 읽어야 합니다: %d", sent)
+	}
+	if got := dispatcher.names(); len(got) != 1 || got[0] != "o-2:order.created" {
+		t.Fatalf("다른 aggregate는 계속 발행되어야 합니다: %v", got)
+	}
+}
+
+func TestRelay_CleanupDeletesOnlyExpiredSentRecords(t *testing.T) {
+	store := outbox.NewMemoryStore()
+	appendEvents(t, store,
+		orderEvent{OrderID: "o-1", Step: "created"},
+		orderEvent{OrderID: "o-2", Step: "created"},
+	)
+	if err := store.MarkSent(context.Background(), 1); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	NewRelay(store, &recordingDispatcher{}, boot.OutboxOptions{Retention: time.Hour}).Cleanup(context.Background())
+	if len(store.Records()) != 2 {
+		t.Fatal("보관 기간이 지나지 않은 레코드는 삭제하면 안 됩니다")
+	}
+
+	NewRelay(store, &recordingDispatcher{}, boot.OutboxOptions{Retention: time.Nanosecond}).Cleanup(context.Background())
+	records := store.Records()
+	if len(records) != 1 || records[0].ID != 2 {
+		t.Fatalf("발행 완료된 레코드만 삭제되어야 합니다: %v", records)
+	}
+}
+
+func TestRelay_StartPollsUntilStopped(t *testing.T) {
+	store := outbox.NewMemoryStore()
+	dispatcher := &recordingDispatcher{}
+	relay := NewRelay(store, dispatcher, boot.OutboxOptions{PollInterval: 5 * time.Millisecond, BatchSize: 1})
+	relay.Start(context.Background())
+
+	appendEvents(t, store,
+		orderEvent{OrderID: "o-1", Step: "created"},
+		orderEvent{OrderID: "o-1", Step: "paid"},
+	)
+
+	deadline := time.Now().Add(time.Second)
+	for len(dispatcher.names()) < 2 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	relay.Stop()
+
+	if got := dispatcher.names(); len(got) != 2 {
+		t.Fatalf("relay는 기록된 이벤트를 모두 발행해야 합니다: %v", got)
+	}
+}
+
+func TestRelay_WaitsForDeliverAtOfDelayedRecords(t *testing.T) {
+	store := outbox.NewMemoryStore()
+	appendEvents(t, store,
+		publish.Delay(orderEvent{OrderID: "o-1", Step: "expired"}, 30*time.Millisecond),
+		orderEvent{OrderID: "o-1", Step: "created"},
+	)
+
+	dispatcher := &recordingDispatcher{}
+	relay := NewRelay(store, dispatcher, boot.OutboxOptions{})
+
+	if sent, _ := relay.RelayOnce(context.Background()); sent != 1 {
+		t.Fatalf("전달 시각이 지나지 않은 레코드는 발행하면 안 됩니다: %d", sent)
+	}
+
+	time.Sleep(40 * time.Millisecond)
+	if sent, _ := relay.RelayOnce(context.Background()); sent != 1 {
+		t.Fatalf("전달 시각이 지난 레코드는 발행되어야 합니다: %d", sent)
+	}
+	if got := dispatcher.names(); len(got) != 2 || got[0] != "o-1:order.created" || got[1] != "o-1:order.expired" {
+		t.Fatalf("지연 레코드는 전달 시각 이후에 발행되어야 합니다: %v", got)
+	}
+	if _, ok := dispatcher.events[1].(publish.DelayedEvent); ok {
+		t.Fatal("relay는 감싼 이벤트가 아닌 기록된 이벤트를 발행해야 합니다")
+	}
+}
+
+func TestRelay_PublishesBodyEncodedWithRegistryCodec(t *testing.T) {
+	codecs := codec.NewRegistry(codec.Protobuf)
+	store := outbox.NewMemoryStore()
+	ctx := outbox.WithCodecs(context.Background(), codecs)
+	if err := outbox.Append(ctx, store, protoOrderEvent{wrapperspb.String("vip")}); err != nil {
+		t.Fatalf("Outbox 기록에 실패했습니다: %v", err)
+	}
+
+	dispatcher := &recordingDispatcher{}
+	if sent, _ := NewRelay(store, dispatcher, boot.OutboxOptions{}).RelayOnce(context.Background()); sent != 1 {
+		t.Fatalf("레코드가 발행되어야 합니다: sent=%d", sent)
+	}
+
+	// Publisher처럼 같은 Registry로 직렬화하면 기록된 Protobuf 본문이 그대로 나가야 한다.
+	msg, err := codecs.Encode(context.Background(), dispatcher.events[0])
+	if err != nil {
+		t.Fatalf("기록된 레코드는 Protobuf Codec으로도 발행되어야 합니다: %v", err)
+	}
+	if msg.Header(codec.ContentTypeHeader) != "application/protobuf" {
+		t.Fatalf("기록할 때의 content-type이 유지되어야 합니다: %v", msg.Headers)
+	}
+	decoded := &wrapperspb.StringValue{}
+	if err := codecs.Decode("order.tagged", msg, decoded); err != nil || !proto.Equal(decoded, wrapperspb.String("vip")) {
+		t.Fatalf("Protobuf 본문이 그대로 발행되어야 합니다: %v (%v)", decoded, err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/NARUBROWN/spine/pkg/event/publish"
 )
@@ -49,13 +50,18 @@ func (d *DefaultEventDispatcher) Dispatch(ctx context.Context, events []publish.
 	var dispatchErrs []error
 
 	for _, e := range events {
+		// 지연 발행 래퍼는 벗기고, 전달 시각이 남아 있으면 Publisher의 지연 전달을 사용한다.
+		event, deliverAt := publish.Delivery(e)
+		// 여러 Publisher가 같은 메시지 ID로 발행하도록 이벤트마다 한 번만 만든다.
+		eventCtx := publish.ContextWithMessageID(ctx, publish.MessageID(ctx, event))
+
 		var wg sync.WaitGroup
 		publisherErrs := make([]error, len(d.publishers))
 		for i, p := range d.publishers {
 			wg.Add(1)
 			go func(index int, p EventPublisher) {
 				defer wg.Done()
-				if err := p.Publish(ctx, e); err != nil {
+				if err := publishEvent(eventCtx, p, event, deliverAt); err != nil {
 					log.Printf("[EventDispatcher] Failed to publish event (%s): %v", e.Name(), err)
 					publisherErrs[index] = fmt.Errorf("failed to publish event (%s): %w", e.Name(), err)
 				}
@@ -72,3 +78,15 @@ func (d *DefaultEventDispatcher) Dispatch(ctx context.Context, events []publish.
 
 	return errors.Join(dispatchErrs...)
 }
+
+func publishEvent(ctx context.Context, p EventPublisher, event publish.DomainEvent, deliverAt time.Time) error {
+	if !deliverAt.After(time.Now()) {
+		return p.Publish(ctx, event)
+	}
+
+	delayed, ok := p.(DelayedPublisher)
+	if !ok {
+		return fmt.Errorf("%T does not support delayed delivery", p)
+	}
+	return delayed.PublishAt(ctx, event, deliverAt)
+}
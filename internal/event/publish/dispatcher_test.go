@@ -191,3 +191,87 @@ func TestDefaultEventDispatcher_PassesCancellationAndJoinsPublisherErrors(t *tes
 		t.Fatal("취소된 context가 publisher에 전달되어야 합니다")
 	}
 }
+
+type delayedTestPublisher struct {
+	testPublisher
+	mu        sync.Mutex
+	events    []pkgevent.DomainEvent
+	deliverAt time.Time
+}
+
+func (p *delayedTestPublisher) PublishAt(ctx context.Context, event pkgevent.DomainEvent, deliverAt time.Time) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.events = append(p.events, event)
+	p.deliverAt = deliverAt
+	return nil
+}
+
+func TestDefaultEventDispatcher_RoutesDelayedEventsToPublishAt(t *testing.T) {
+	delayed := &delayedTestPublisher{}
+	immediateOnly := &testPublisher{}
+	dispatcher, err := NewDefaultEventDispatcher(delayed, immediateOnly)
+	if err != nil {
+		t.Fatalf("dispatcher 생성 실패: %v", err)
+	}
+
+	inner := testDomainEvent{name: "order.expired", at: time.Now()}
+	deliverAt := time.Now().Add(time.Hour)
+	err = dispatcher.Dispatch(context.Background(), []pkgevent.DomainEvent{pkgevent.DelayUntil(inner, deliverAt)})
+
+	if err == nil {
+		t.Fatal("지연 발행을 지원하지 않는 publisher는 에러를 반환해야 합니다")
+	}
+	if immediateOnly.called.Load() != 0 {
+		t.Fatal("지연 이벤트를 즉시 발행하면 안 됩니다")
+	}
+	if len(delayed.events) != 1 || delayed.events[0] != inner || !delayed.deliverAt.Equal(deliverAt) {
+		t.Fatalf("감싼 이벤트와 전달 시각이 PublishAt으로 전달되어야 합니다: %v %v", delayed.events, delayed.deliverAt)
+	}
+}
+
+func TestDefaultEventDispatcher_PublishesPastDeliverAtImmediately(t *testing.T) {
+	delayed := &delayedTestPublisher{}
+	dispatcher, err := NewDefaultEventDispatcher(delayed)
+	if err != nil {
+		t.Fatalf("dispatcher 생성 실패: %v", err)
+	}
+
+	event := pkgevent.Delay(testDomainEvent{name: "order.expired", at: time.Now()}, -time.Second)
+	if err := dispatcher.Dispatch(context.Background(), []pkgevent.DomainEvent{event}); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if delayed.called.Load() != 1 || len(delayed.events) != 0 {
+		t.Fatal("전달 시각이 지난 이벤트는 즉시 발행되어야 합니다")
+	}
+}
+
+func TestDefaultEventDispatcher_PublishersShareMessageID(t *testing.T) {
+	var mu sync.Mutex
+	var ids []string
+	record := &funcPublisher{publish: func(ctx context.Context, event pkgevent.DomainEvent) error {
+		mu.Lock()
+		defer mu.Unlock()
+		ids = append(ids, pkgevent.MessageHeaders(ctx, event)[pkgevent.MessageIDHeader])
+		return nil
+	}}
+
+	dispatcher, err := NewDefaultEventDispatcher(record, record)
+	if err != nil {
+		t.Fatalf("dispatcher 생성 실패: %v", err)
+	}
+	err = dispatcher.Dispatch(context.Background(), []pkgevent.DomainEvent{
+		testDomainEvent{name: "order.created", at: time.Now()},
+		testDomainEvent{name: "order.paid", at: time.Now()},
+	})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	if len(ids) != 4 || ids[0] == "" || ids[0] != ids[1] || ids[2] != ids[3] {
+		t.Fatalf("같은 이벤트는 모든 Publisher에서 같은 메시지 ID를 가져야 합니다: %v", ids)
+	}
+	if ids[0] == ids[2] {
+		t.Fatalf("이벤트마다 다른 메시지 ID를 가져야 합니다: %v", ids)
+	}
+}
@@ -2,6 +2,7 @@ package publish
 
 import (
 	"context"
+	"time"
 
 	"github.com/NARUBROWN/spine/pkg/event/publish"
 )
@@ -9,3 +10,8 @@ import (
 type EventPublisher interface {
 	Publish(ctx context.Context, event publish.DomainEvent) error
 }
+
+// DelayedPublisher는 지정한 시각 이후에 전달되는 발행을 지원하는 Publisher입니다.
+type DelayedPublisher interface {
+	PublishAt(ctx context.Context, event publish.DomainEvent, deliverAt time.Time) error
+}
@@ -0,0 +1,124 @@
+package publish
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+// Reply는 Consumer가 요청에 대해 보낸 응답입니다.
+type Reply struct {
+	Body    []byte
+	Headers map[string]string
+}
+
+// ReplyRouter는 브로커에서 받은 응답을 correlation ID로 기다리는 요청에 전달합니다.
+type ReplyRouter struct {
+	mu      sync.Mutex
+	pending map[string]chan Reply
+}
+
+func NewReplyRouter() *ReplyRouter {
+	return &ReplyRouter{pending: make(map[string]chan Reply)}
+}
+
+// register는 응답을 받을 채널을 만들고, 기다림을 끝낼 때 호출할 함수를 반환합니다.
+func (r *ReplyRouter) register(correlationID string) (<-chan Reply, func()) {
+	ch := make(chan Reply, 1)
+
+	r.mu.Lock()
+	r.pending[correlationID] = ch
+	r.mu.Unlock()
+
+	return ch, func() {
+		r.mu.Lock()
+		delete(r.pending, correlationID)
+		r.mu.Unlock()
+	}
+}
+
+// Deliver는 응답을 기다리는 요청에 전달합니다. 기다리는 요청이 없으면(이미 시간 초과 등) false를 반환합니다.
+func (r *ReplyRouter) Deliver(correlationID string, reply Reply) bool {
+	r.mu.Lock()
+	ch, ok := r.pending[correlationID]
+	delete(r.pending, correlationID)
+	r.mu.Unlock()
+
+	if !ok {
+		return false
+	}
+	ch <- reply
+	return true
+}
+
+/*
+DefaultRequester는 Publisher로 요청을 발행하고 ReplyRouter로 응답을 기다립니다.
+응답 주소(replyTo)와 응답 수신은 브로커마다 다르므로 생성하는 쪽에서 구성합니다.
+*/
+type DefaultRequester struct {
+	publisher EventPublisher
+	replyTo   string
+	replies   *ReplyRouter
+}
+
+func NewRequester(publisher EventPublisher, replyTo string, replies *ReplyRouter) (*DefaultRequester, error) {
+	if isNilEventPublisher(publisher) {
+		return nil, errors.New("requester publisher cannot be nil")
+	}
+	if replyTo == "" {
+		return nil, errors.New("requester reply address cannot be empty")
+	}
+	if replies == nil {
+		return nil, errors.New("requester reply router cannot be nil")
+	}
+
+	return &DefaultRequester{
+		publisher: publisher,
+		replyTo:   replyTo,
+		replies:   replies,
+	}, nil
+}
+
+func (r *DefaultRequester) Request(ctx context.Context, event publish.DomainEvent) ([]byte, error) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(publish.DefaultRequestTimeout)
+	}
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	correlationID := newRequestID()
+	wait, done := r.replies.register(correlationID)
+	defer done()
+
+	ctx = publish.ContextWithHeaders(ctx, map[string]string{
+		publish.ReplyToHeader:          r.replyTo,
+		publish.ReplyCorrelationHeader: correlationID,
+		publish.RequestDeadlineHeader:  deadline.UTC().Format(time.RFC3339Nano),
+	})
+	if err := r.publisher.Publish(ctx, event); err != nil {
+		return nil, fmt.Errorf("failed to publish request (%s): %w", event.Name(), err)
+	}
+
+	select {
+	case reply := <-wait:
+		if message, failed := reply.Headers[publish.ReplyErrorHeader]; failed {
+			return nil, &publish.ReplyError{Message: message}
+		}
+		return reply.Body, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("no reply for request (%s): %w", event.Name(), ctx.Err())
+	}
+}
+
+func newRequestID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
@@ -0,0 +1,97 @@
+package publish
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pkgevent "github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+// replyingPublisher는 발행된 요청의 헤더로 응답을 만들어 곧바로 돌려보냅니다.
+func replyingPublisher(replies *ReplyRouter, reply func(headers map[string]string) Reply) *funcPublisher {
+	return &funcPublisher{publish: func(ctx context.Context, event pkgevent.DomainEvent) error {
+		headers := pkgevent.HeadersFromContext(ctx)
+		go replies.Deliver(headers[pkgevent.ReplyCorrelationHeader], reply(headers))
+		return nil
+	}}
+}
+
+func TestRequester_ReturnsReplyBody(t *testing.T) {
+	replies := NewReplyRouter()
+	var requestHeaders map[string]string
+	requester, err := NewRequester(replyingPublisher(replies, func(headers map[string]string) Reply {
+		requestHeaders = headers
+		return Reply{Body: []byte(`{"price":100}`)}
+	}), "quotes.reply", replies)
+	if err != nil {
+		t.Fatalf("requester 생성 실패: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	deadline, _ := ctx.Deadline()
+
+	reply, err := requester.Request(ctx, testDomainEvent{name: "quote.requested", at: time.Now()})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if string(reply) != `{"price":100}` {
+		t.Fatalf("응답 본문이 잘못되었습니다: %s", reply)
+	}
+
+	if requestHeaders[pkgevent.ReplyToHeader] != "quotes.reply" || requestHeaders[pkgevent.ReplyCorrelationHeader] == "" {
+		t.Fatalf("요청에 응답 주소와 correlation ID가 실려야 합니다: %v", requestHeaders)
+	}
+	if got, err := time.Parse(time.RFC3339Nano, requestHeaders[pkgevent.RequestDeadlineHeader]); err != nil || !got.Equal(deadline) {
+		t.Fatalf("호출자의 마감 시각이 요청에 실려야 합니다: %v (%v)", requestHeaders[pkgevent.RequestDeadlineHeader], err)
+	}
+}
+
+func TestRequester_ReturnsReplyError(t *testing.T) {
+	replies := NewReplyRouter()
+	requester, _ := NewRequester(replyingPublisher(replies, func(map[string]string) Reply {
+		return Reply{Headers: map[string]string{pkgevent.ReplyErrorHeader: "out of stock"}}
+	}), "quotes.reply", replies)
+
+	_, err := requester.Request(context.Background(), testDomainEvent{name: "quote.requested", at: time.Now()})
+
+	var replyErr *pkgevent.ReplyError
+	if !errors.As(err, &replyErr) || replyErr.Message != "out of stock" {
+		t.Fatalf("실패 응답은 ReplyError로 반환되어야 합니다: %v", err)
+	}
+}
+
+func TestRequester_TimesOutAtContextDeadline(t *testing.T) {
+	replies := NewReplyRouter()
+	requester, _ := NewRequester(&testPublisher{}, "quotes.reply", replies)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	started := time.Now()
+	_, err := requester.Request(ctx, testDomainEvent{name: "quote.requested", at: time.Now()})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("응답이 없으면 마감 시각에 시간 초과되어야 합니다: %v", err)
+	}
+	if elapsed := time.Since(started); elapsed > time.Second {
+		t.Fatalf("context 마감 시각을 넘겨 기다렸습니다: %v", elapsed)
+	}
+
+	if replies.Deliver("late", Reply{}) {
+		t.Fatal("기다리는 요청이 없는 응답은 전달되면 안 됩니다")
+	}
+	if len(replies.pending) != 0 {
+		t.Fatalf("시간 초과된 요청은 대기 목록에서 빠져야 합니다: %d", len(replies.pending))
+	}
+}
+
+func TestRequester_ReturnsPublishError(t *testing.T) {
+	sentinel := errors.New("broker unavailable")
+	requester, _ := NewRequester(&testPublisher{err: sentinel}, "quotes.reply", NewReplyRouter())
+
+	if _, err := requester.Request(context.Background(), testDomainEvent{name: "quote.requested", at: time.Now()}); !errors.Is(err, sentinel) {
+		t.Fatalf("발행 실패는 그대로 반환되어야 합니다: %v", err)
+	}
+}
@@ -33,16 +33,22 @@ func (h *ErrorReturnHandler) Handle(value any, ctx core.ExecutionContext) error
 	}
 
 	status := 500
-	message := "Internal server error"
+	body := map[string]any{
+		"message": "Internal server error",
+	}
 
 	// HTTPError면 상태 코드를 추출한다.
 	var httpErr *httperr.HTTPError
 	if errors.As(err, &httpErr) {
 		status = httpErr.Status
-		message = httpErr.Message
+		body["message"] = httpErr.Message
+		for key, value := range httpErr.Headers {
+			rw.SetHeader(key, value)
+		}
+		if httpErr.Details != nil {
+			body["details"] = httpErr.Details
+		}
 	}
 
-	return rw.WriteJSON(status, map[string]any{
-		"message": message,
-	})
+	return rw.WriteJSON(status, body)
 }
@@ -285,6 +285,33 @@ func TestErrorReturnHandler_HTTPError(t *testing.T) {
 	}
 }
 
+func TestErrorReturnHandler_HTTPErrorDetails(t *testing.T) {
+	h := &ErrorReturnHandler{}
+	ctx := newFakeExecutionContext()
+	writer := newFakeWriter()
+	ctx.Set("spine.response_writer", writer)
+
+	details := []string{"name is required"}
+	err := &httperr.HTTPError{Status: 400, Message: "Validation failed", Details: details}
+	if err := h.Handle(err, ctx); err != nil {
+		t.Fatalf("ErrorReturnHandler 실패: %v", err)
+	}
+	body := writer.jsonBody.(map[string]any)
+	if !reflect.DeepEqual(body["details"], details) {
+		t.Fatalf("details가 응답에 포함되어야 합니다: %v", body)
+	}
+
+	// Details가 없으면 details 키를 내리지 않는다.
+	writer = newFakeWriter()
+	ctx.Set("spine.response_writer", writer)
+	if err := h.Handle(httperr.BadRequest("bad"), ctx); err != nil {
+		t.Fatalf("ErrorReturnHandler 실패: %v", err)
+	}
+	if _, ok := writer.jsonBody.(map[string]any)["details"]; ok {
+		t.Fatalf("details가 없으면 키가 없어야 합니다: %v", writer.jsonBody)
+	}
+}
+
 func TestErrorReturnHandler_GenericError(t *testing.T) {
 	h := &ErrorReturnHandler{}
 	ctx := newFakeExecutionContext()
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/NARUBROWN/spine/internal/container"
+	"github.com/NARUBROWN/spine/pkg/di"
 )
 
 type Invoker struct {
@@ -20,8 +21,18 @@ func NewInvoker(container *container.Container) *Invoker {
 	}
 }
 
+// NewScope는 하나의 실행 단위에서 사용할 DI 스코프를 생성합니다.
+func (i *Invoker) NewScope() *container.Scope {
+	return i.container.NewScope()
+}
+
 func (i *Invoker) Invoke(controllerType reflect.Type, method reflect.Method, args []any) ([]any, error) {
-	controller, err := i.controllerValue(controllerType)
+	return i.InvokeInScope(nil, controllerType, method, args)
+}
+
+// InvokeInScope는 Scoped / Transient 컨트롤러를 주어진 스코프에서 Resolve한 뒤 메서드를 호출합니다.
+func (i *Invoker) InvokeInScope(scope *container.Scope, controllerType reflect.Type, method reflect.Method, args []any) ([]any, error) {
+	controller, err := i.controllerValue(scope, controllerType)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +53,7 @@ func (i *Invoker) Invoke(controllerType reflect.Type, method reflect.Method, arg
 	return out, nil
 }
 
-func (i *Invoker) controllerValue(controllerType reflect.Type) (reflect.Value, error) {
+func (i *Invoker) controllerValue(scope *container.Scope, controllerType reflect.Type) (reflect.Value, error) {
 	i.mu.RLock()
 	if value, ok := i.cached[controllerType]; ok {
 		i.mu.RUnlock()
@@ -50,6 +61,25 @@ func (i *Invoker) controllerValue(controllerType reflect.Type) (reflect.Value, e
 	}
 	i.mu.RUnlock()
 
+	lifetime, err := i.container.Lifetime(controllerType)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	// Singleton이 아닌 컨트롤러는 실행마다 새로 Resolve하며 캐시하지 않는다.
+	if lifetime != di.LifetimeSingleton {
+		var controller any
+		if scope != nil {
+			controller, err = scope.Resolve(controllerType)
+		} else {
+			controller, err = i.container.Resolve(controllerType)
+		}
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		return reflect.ValueOf(controller), nil
+	}
+
 	controller, err := i.container.Resolve(controllerType)
 	if err != nil {
 		return reflect.Value{}, err
@@ -0,0 +1,439 @@
+package openapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/NARUBROWN/spine/core"
+	"github.com/NARUBROWN/spine/internal/router"
+	"github.com/NARUBROWN/spine/pkg/header"
+	"github.com/NARUBROWN/spine/pkg/httpx"
+	"github.com/NARUBROWN/spine/pkg/multipart"
+	"github.com/NARUBROWN/spine/pkg/path"
+	"github.com/NARUBROWN/spine/pkg/query"
+)
+
+var (
+	contextType           = reflect.TypeFor[context.Context]()
+	controllerContextType = reflect.TypeFor[core.ControllerContext]()
+	errorType             = reflect.TypeFor[error]()
+	headerValuesType      = reflect.TypeFor[header.Values]()
+	queryValuesType       = reflect.TypeFor[query.Values]()
+	paginationType        = reflect.TypeFor[query.Pagination]()
+	uploadedFilesType     = reflect.TypeFor[multipart.UploadedFiles]()
+	binaryType            = reflect.TypeFor[httpx.Binary]()
+	redirectType          = reflect.TypeFor[httpx.Redirect]()
+	pathPkgPath           = reflect.TypeFor[path.Int]().PkgPath()
+	httpxPkgPath          = reflect.TypeFor[httpx.Binary]().PkgPath()
+)
+
+// errorSchemaName은 Spine 기본 에러 응답 본문 스키마 이름입니다.
+const errorSchemaName = "SpineError"
+
+/*
+Generate는 등록된 라우트로부터 OpenAPI 3.1 문서를 생성합니다.
+prefix는 HTTP 전역 Prefix이며, 각 라우트 경로 앞에 붙습니다.
+
+파라미터 타입은 ArgumentResolver와 같은 규칙으로 해석합니다.
+  - path.*           : path 파라미터 (라우트 선언 순서)
+  - query 태그 struct : query 파라미터
+  - query.Pagination : page / size query 파라미터
+  - header.Values    : route.WithHeaders로 선언한 헤더
+  - *Struct          : JSON 요청 본문
+  - form 태그 struct, multipart.UploadedFiles : form / multipart 요청 본문
+*/
+func Generate(info Info, prefix string, routes []router.RouteSpec) (*Document, error) {
+	if info.Title == "" {
+		info.Title = "Spine API"
+	}
+	if info.Version == "" {
+		info.Version = "0.0.0"
+	}
+
+	g := &generator{
+		schemas:      newSchemaRegistry(),
+		operationIDs: make(map[string]int),
+	}
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   make(map[string]map[string]*Operation),
+	}
+
+	prefix = strings.TrimSuffix(prefix, "/")
+	for _, route := range routes {
+		meta, err := router.NewHandlerMeta(route.Handler)
+		if err != nil {
+			return nil, fmt.Errorf("failed to document route (%s) %s: %w", route.Method, route.Path, err)
+		}
+
+		routePath := route.Path
+		if !strings.HasPrefix(routePath, "/") {
+			routePath = "/" + routePath
+		}
+		fullPath := prefix + routePath
+
+		docPath, pathKeys := toOpenAPIPath(fullPath)
+		operation := g.operation(route, meta, pathKeys)
+
+		item := doc.Paths[docPath]
+		if item == nil {
+			item = make(map[string]*Operation)
+			doc.Paths[docPath] = item
+		}
+		item[strings.ToLower(route.Method)] = operation
+	}
+
+	if len(g.schemas.schemas) > 0 {
+		doc.Components = &Components{Schemas: g.schemas.schemas}
+	}
+	return doc, nil
+}
+
+type generator struct {
+	schemas      *schemaRegistry
+	operationIDs map[string]int
+}
+
+func (g *generator) operation(route router.RouteSpec, meta core.HandlerMeta, pathKeys []string) *Operation {
+	op := &Operation{
+		OperationID: g.operationID(meta),
+		Summary:     route.Doc.Summary,
+		Description: route.Doc.Description,
+		Tags:        append([]string(nil), route.Doc.Tags...),
+		Deprecated:  route.Doc.Deprecated,
+		Responses:   make(map[string]*Response),
+	}
+
+	g.applyParameters(op, route, meta, pathKeys)
+	g.applyResponses(op, route, meta)
+	return op
+}
+
+// operationID는 Controller.Method 형태의 고유 ID를 만듭니다.
+func (g *generator) operationID(meta core.HandlerMeta) string {
+	controller := meta.ControllerType
+	for controller.Kind() == reflect.Pointer {
+		controller = controller.Elem()
+	}
+
+	id := controller.Name() + "." + meta.Method.Name
+	g.operationIDs[id]++
+	if count := g.operationIDs[id]; count > 1 {
+		id += strconv.Itoa(count)
+	}
+	return id
+}
+
+func (g *generator) applyParameters(op *Operation, route router.RouteSpec, meta core.HandlerMeta, pathKeys []string) {
+	pathIdx := 0
+	var formSchema *Schema
+	hasFiles := false
+
+	for i := 1; i < meta.Method.Type.NumIn(); i++ {
+		pt := meta.Method.Type.In(i)
+
+		switch {
+		case pt == contextType, pt == queryValuesType,
+			pt.Kind() == reflect.Interface && pt.Implements(controllerContextType):
+			// 문서화할 입력이 없는 타입
+
+		case pt.PkgPath() == pathPkgPath:
+			if pathIdx < len(pathKeys) {
+				op.Parameters = append(op.Parameters, Parameter{
+					Name:     pathKeys[pathIdx],
+					In:       "path",
+					Required: true,
+					Schema:   pathParamSchema(pt),
+				})
+			}
+			pathIdx++
+
+		case pt == paginationType:
+			op.Parameters = append(op.Parameters,
+				Parameter{Name: "page", In: "query", Schema: &Schema{Type: "integer", Format: "int32", Default: 1}},
+				Parameter{Name: "size", In: "query", Schema: &Schema{Type: "integer", Format: "int32", Default: 20}},
+			)
+
+		case pt == headerValuesType:
+			for _, name := range route.Doc.Headers {
+				op.Parameters = append(op.Parameters, Parameter{Name: name, In: "header", Schema: &Schema{Type: "string"}})
+			}
+
+		case pt == uploadedFilesType:
+			hasFiles = true
+
+		case hasTag(pt, "query"):
+			op.Parameters = append(op.Parameters, g.queryParameters(pt)...)
+
+		case hasTag(pt, "form"):
+			formSchema = g.formSchema(pt)
+
+		case pt.Kind() == reflect.Pointer && pt.Elem().Kind() == reflect.Struct:
+			op.RequestBody = &RequestBody{
+				Required: true,
+				Content: map[string]*MediaType{
+					"application/json": {Schema: g.schemas.schemaFor(pt)},
+				},
+			}
+		}
+	}
+
+	// path 파라미터 타입이 선언되지 않은 키도 문서에는 있어야 한다. (OpenAPI 요구사항)
+	for ; pathIdx < len(pathKeys); pathIdx++ {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     pathKeys[pathIdx],
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "string"},
+		})
+	}
+
+	if formSchema == nil && !hasFiles {
+		return
+	}
+
+	if formSchema == nil {
+		formSchema = &Schema{Type: "object", Properties: map[string]*Schema{}}
+	}
+	content := map[string]*MediaType{}
+	if hasFiles {
+		formSchema.AdditionalProperties = &Schema{
+			Type:  "array",
+			Items: &Schema{Type: "string", Format: "binary"},
+		}
+	} else {
+		content["application/x-www-form-urlencoded"] = &MediaType{Schema: formSchema}
+	}
+	content["multipart/form-data"] = &MediaType{Schema: formSchema}
+	op.RequestBody = &RequestBody{Required: true, Content: content}
+}
+
+func pathParamSchema(pt reflect.Type) *Schema {
+	switch pt {
+	case reflect.TypeFor[path.Int]():
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.TypeFor[path.Boolean]():
+		return &Schema{Type: "boolean"}
+	default:
+		return &Schema{Type: "string"}
+	}
+}
+
+func (g *generator) queryParameters(pt reflect.Type) []Parameter {
+	structType := derefType(pt)
+
+	var params []Parameter
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("query"), ",")
+		if name == "" || name == "-" || !field.IsExported() {
+			continue
+		}
+
+		schema := g.schemas.schemaFor(field.Type)
+		required := applyValidateTag(schema, field)
+		if def, ok := field.Tag.Lookup("default"); ok {
+			schema.Default = typedDefault(schema, def)
+		}
+		params = append(params, Parameter{
+			Name:     name,
+			In:       "query",
+			Required: required,
+			Schema:   schema,
+		})
+	}
+	return params
+}
+
+// typedDefault는 default 태그 문자열을 스키마 타입에 맞는 값으로 변환합니다.
+func typedDefault(schema *Schema, raw string) any {
+	switch schema.Type {
+	case "integer":
+		if v, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			return v
+		}
+	case "number":
+		if v, err := strconv.ParseFloat(raw, 64); err == nil {
+			return v
+		}
+	case "boolean":
+		if v, err := strconv.ParseBool(raw); err == nil {
+			return v
+		}
+	case "array":
+		items := strings.Split(raw, ",")
+		values := make([]any, len(items))
+		for i, item := range items {
+			values[i] = typedDefault(schema.Items, item)
+		}
+		return values
+	}
+	return raw
+}
+
+func (g *generator) formSchema(pt reflect.Type) *Schema {
+	structType := derefType(pt)
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("form"), ",")
+		if name == "" || name == "-" || !field.IsExported() {
+			continue
+		}
+
+		fieldSchema := g.schemas.schemaFor(field.Type)
+		if applyValidateTag(fieldSchema, field) {
+			schema.Required = append(schema.Required, name)
+		}
+		schema.Properties[name] = fieldSchema
+	}
+	return schema
+}
+
+func (g *generator) applyResponses(op *Operation, route router.RouteSpec, meta core.HandlerMeta) {
+	returnsError := false
+	documented := false
+
+	for i := 0; i < meta.Method.Type.NumOut(); i++ {
+		out := meta.Method.Type.Out(i)
+		if out == errorType || (out.Kind() == reflect.Interface && out.Implements(errorType)) {
+			returnsError = true
+			continue
+		}
+
+		status, response := g.successResponse(out)
+		if response == nil {
+			continue
+		}
+		op.Responses[status] = response
+		documented = true
+	}
+
+	if !documented {
+		op.Responses["200"] = &Response{Description: "OK"}
+	}
+
+	for _, extra := range route.Doc.Responses {
+		description := extra.Description
+		if description == "" {
+			description = http.StatusText(extra.Status)
+		}
+		response := &Response{Description: description}
+		if extra.BodyType != nil {
+			response.Content = map[string]*MediaType{
+				"application/json": {Schema: g.schemas.schemaFor(extra.BodyType)},
+			}
+		}
+		op.Responses[strconv.Itoa(extra.Status)] = response
+	}
+
+	if returnsError {
+		op.Responses["default"] = &Response{
+			Description: "Error",
+			Content: map[string]*MediaType{
+				"application/json": {Schema: g.errorSchema()},
+			},
+		}
+	}
+}
+
+// successResponse는 ReturnValueHandler 규칙에 따라 반환 타입을 응답 문서로 변환합니다.
+// 상태 코드는 런타임 값(ResponseOptions.Status)이므로 기본 상태 코드로 기록합니다.
+func (g *generator) successResponse(out reflect.Type) (string, *Response) {
+	out = derefType(out)
+
+	switch {
+	case out == binaryType:
+		return "200", &Response{
+			Description: "OK",
+			Content: map[string]*MediaType{
+				"application/octet-stream": {Schema: &Schema{Type: "string", Format: "binary"}},
+			},
+		}
+
+	case out == redirectType:
+		return "302", &Response{Description: "Found"}
+
+	case out.PkgPath() == httpxPkgPath && strings.HasPrefix(out.Name(), "Response["):
+		field, ok := out.FieldByName("Body")
+		if !ok {
+			return "", nil
+		}
+		if field.Type.Kind() == reflect.String {
+			return "200", &Response{
+				Description: "OK",
+				Content: map[string]*MediaType{
+					"text/plain": {Schema: &Schema{Type: "string"}},
+				},
+			}
+		}
+		return "200", &Response{
+			Description: "OK",
+			Content: map[string]*MediaType{
+				"application/json": {Schema: g.schemas.schemaFor(field.Type)},
+			},
+		}
+	}
+
+	return "", nil
+}
+
+func (g *generator) errorSchema() *Schema {
+	if _, ok := g.schemas.schemas[errorSchemaName]; !ok {
+		g.schemas.schemas[errorSchemaName] = &Schema{
+			Type: "object",
+			Properties: map[string]*Schema{
+				"message": {Type: "string"},
+				"details": {},
+			},
+			Required: []string{"message"},
+		}
+	}
+	return &Schema{Ref: "#/components/schemas/" + errorSchemaName}
+}
+
+// toOpenAPIPath는 :id, :id<int>, *path 형태의 경로를 {id} 형태로 바꾸고 path key 순서를 반환합니다.
+func toOpenAPIPath(routePath string) (string, []string) {
+	segments := strings.Split(routePath, "/")
+	var keys []string
+	for i, seg := range segments {
+		var key string
+		switch {
+		case strings.HasPrefix(seg, ":"):
+			key, _, _ = strings.Cut(seg[1:], "<")
+		case strings.HasPrefix(seg, "*"):
+			key = seg[1:]
+		default:
+			continue
+		}
+		keys = append(keys, key)
+		segments[i] = "{" + key + "}"
+	}
+	return strings.Join(segments, "/"), keys
+}
+
+func hasTag(t reflect.Type, tag string) bool {
+	t = derefType(t)
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get(tag) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func derefType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
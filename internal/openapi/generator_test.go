@@ -0,0 +1,206 @@
+package openapi
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/NARUBROWN/spine/internal/router"
+	"github.com/NARUBROWN/spine/pkg/header"
+	"github.com/NARUBROWN/spine/pkg/httpx"
+	"github.com/NARUBROWN/spine/pkg/multipart"
+	"github.com/NARUBROWN/spine/pkg/path"
+	"github.com/NARUBROWN/spine/pkg/query"
+)
+
+type docUser struct {
+	ID    int64  `json:"id"`
+	Email string `json:"email" validate:"required,email"`
+}
+
+type docCreateUser struct {
+	Name string `json:"name" validate:"required,min=2,max=20"`
+	Role string `json:"role" validate:"oneof=admin member"`
+}
+
+type docSearch struct {
+	Keyword string   `query:"q" validate:"required"`
+	Page    int      `query:"page" default:"1"`
+	Tags    []string `query:"tag"`
+}
+
+type docUpload struct {
+	Title string `form:"title" validate:"required"`
+}
+
+type docPage[T any] struct {
+	Items []T `json:"items"`
+}
+
+type docErrorBody struct {
+	Code string `json:"code"`
+}
+
+type docController struct{}
+
+func (c *docController) Get(ctx context.Context, id path.Int, h header.Values) (httpx.Response[docUser], error) {
+	return httpx.Response[docUser]{}, nil
+}
+
+func (c *docController) Create(req *docCreateUser) httpx.Response[docUser] {
+	return httpx.Response[docUser]{}
+}
+
+func (c *docController) Search(q docSearch, p query.Pagination) httpx.Response[docPage[docUser]] {
+	return httpx.Response[docPage[docUser]]{}
+}
+
+func (c *docController) Upload(form *docUpload, files multipart.UploadedFiles) httpx.Response[string] {
+	return httpx.Response[string]{}
+}
+
+func (c *docController) Download() httpx.Binary {
+	return httpx.Binary{}
+}
+
+func generateTestDocument(t *testing.T) *Document {
+	t.Helper()
+
+	routes := []router.RouteSpec{
+		{
+			Method:  "GET",
+			Path:    "/users/:id",
+			Handler: (*docController).Get,
+			Doc: router.RouteDoc{
+				Summary: "사용자 조회",
+				Tags:    []string{"users"},
+				Headers: []string{"X-Request-ID"},
+				Responses: []router.ResponseDoc{
+					{Status: 404, Description: "사용자 없음", BodyType: reflect.TypeFor[docErrorBody]()},
+				},
+			},
+		},
+		{Method: "POST", Path: "/users", Handler: (*docController).Create},
+		{Method: "GET", Path: "/users", Handler: (*docController).Search},
+		{Method: "POST", Path: "/uploads", Handler: (*docController).Upload},
+		{Method: "GET", Path: "files", Handler: (*docController).Download},
+	}
+
+	doc, err := Generate(Info{Title: "Test API", Version: "1.0.0"}, "/api/", routes)
+	if err != nil {
+		t.Fatalf("문서 생성 실패: %v", err)
+	}
+	return doc
+}
+
+func TestGenerate_PathParametersHeadersAndExtraResponses(t *testing.T) {
+	doc := generateTestDocument(t)
+
+	if doc.OpenAPI != "3.1.0" || doc.Info.Title != "Test API" {
+		t.Fatalf("문서 헤더가 잘못되었습니다: %+v", doc)
+	}
+
+	op := doc.Paths["/api/users/{id}"]["get"]
+	if op == nil {
+		t.Fatalf("path 파라미터 경로가 {id} 형태로 변환되어야 합니다: %v", doc.Paths)
+	}
+	if op.OperationID != "docController.Get" || op.Summary != "사용자 조회" || op.Tags[0] != "users" {
+		t.Fatalf("operation 정보가 잘못되었습니다: %+v", op)
+	}
+
+	if len(op.Parameters) != 2 {
+		t.Fatalf("path + header 파라미터가 있어야 합니다: %+v", op.Parameters)
+	}
+	if p := op.Parameters[0]; p.Name != "id" || p.In != "path" || !p.Required || p.Schema.Type != "integer" {
+		t.Fatalf("path 파라미터가 잘못되었습니다: %+v", p)
+	}
+	if p := op.Parameters[1]; p.Name != "X-Request-ID" || p.In != "header" {
+		t.Fatalf("header 파라미터가 잘못되었습니다: %+v", p)
+	}
+
+	if ref := op.Responses["200"].Content["application/json"].Schema.Ref; ref != "#/components/schemas/docUser" {
+		t.Fatalf("Response[T] 본문이 컴포넌트 참조여야 합니다: %s", ref)
+	}
+	if op.Responses["404"] == nil || op.Responses["404"].Description != "사용자 없음" {
+		t.Fatalf("추가 응답이 문서화되어야 합니다: %+v", op.Responses)
+	}
+	if op.Responses["default"] == nil {
+		t.Fatal("error를 반환하면 기본 에러 응답이 문서화되어야 합니다")
+	}
+
+	user := doc.Components.Schemas["docUser"]
+	if user.Properties["email"].Format != "email" || !reflect.DeepEqual(user.Required, []string{"email"}) {
+		t.Fatalf("validate 태그가 스키마에 반영되어야 합니다: %+v", user)
+	}
+}
+
+func TestGenerate_RequestBodiesAndQueryParameters(t *testing.T) {
+	doc := generateTestDocument(t)
+
+	create := doc.Paths["/api/users"]["post"]
+	if create.RequestBody == nil || create.RequestBody.Content["application/json"].Schema.Ref != "#/components/schemas/docCreateUser" {
+		t.Fatalf("포인터 DTO는 JSON 요청 본문이어야 합니다: %+v", create.RequestBody)
+	}
+	body := doc.Components.Schemas["docCreateUser"]
+	if *body.Properties["name"].MinLength != 2 || *body.Properties["name"].MaxLength != 20 {
+		t.Fatalf("min/max가 길이 제약으로 반영되어야 합니다: %+v", body.Properties["name"])
+	}
+	if len(body.Properties["role"].Enum) != 2 {
+		t.Fatalf("oneof가 enum으로 반영되어야 합니다: %+v", body.Properties["role"])
+	}
+
+	search := doc.Paths["/api/users"]["get"]
+	names := make([]string, 0, len(search.Parameters))
+	for _, p := range search.Parameters {
+		if p.In != "query" {
+			t.Fatalf("query 파라미터여야 합니다: %+v", p)
+		}
+		names = append(names, p.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"q", "page", "tag", "page", "size"}) {
+		t.Fatalf("query 파라미터 목록이 잘못되었습니다: %v", names)
+	}
+	if !search.Parameters[0].Required || search.Parameters[1].Schema.Default != int64(1) || search.Parameters[2].Schema.Type != "array" {
+		t.Fatalf("query 파라미터 스키마가 잘못되었습니다: %+v", search.Parameters)
+	}
+	if ref := search.Responses["200"].Content["application/json"].Schema.Ref; ref != "#/components/schemas/docPage_docUser" {
+		t.Fatalf("제네릭 타입 스키마 이름이 잘못되었습니다: %s", ref)
+	}
+}
+
+func TestGenerate_MultipartAndBinary(t *testing.T) {
+	doc := generateTestDocument(t)
+
+	upload := doc.Paths["/api/uploads"]["post"]
+	media := upload.RequestBody.Content["multipart/form-data"]
+	if media == nil || media.Schema.Properties["title"] == nil || media.Schema.AdditionalProperties.Items.Format != "binary" {
+		t.Fatalf("multipart 요청 본문이 잘못되었습니다: %+v", upload.RequestBody)
+	}
+	if _, ok := upload.RequestBody.Content["application/x-www-form-urlencoded"]; ok {
+		t.Fatal("파일 업로드가 있으면 urlencoded 본문은 문서화하지 않아야 합니다")
+	}
+	if upload.Responses["200"].Content["text/plain"] == nil {
+		t.Fatalf("Response[string]은 text/plain이어야 합니다: %+v", upload.Responses)
+	}
+
+	download := doc.Paths["/api/files"]["get"]
+	if download.Responses["200"].Content["application/octet-stream"] == nil {
+		t.Fatalf("Binary 응답은 octet-stream이어야 합니다: %+v", download.Responses)
+	}
+
+	encoded, err := doc.JSON()
+	if err != nil || !json.Valid(encoded) {
+		t.Fatalf("문서 JSON 직렬화 실패: %v", err)
+	}
+}
+
+func TestToOpenAPIPath_ConstrainedAndWildcardSegments(t *testing.T) {
+	docPath, keys := toOpenAPIPath("/repos/:id<int>/files/*path")
+	if docPath != "/repos/{id}/files/{path}" {
+		t.Fatalf("문서 경로 변환이 잘못되었습니다: %s", docPath)
+	}
+	if len(keys) != 2 || keys[0] != "id" || keys[1] != "path" {
+		t.Fatalf("path key 순서가 잘못되었습니다: %v", keys)
+	}
+}
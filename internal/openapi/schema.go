@@ -0,0 +1,237 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	timeType     = reflect.TypeFor[time.Time]()
+	durationType = reflect.TypeFor[time.Duration]()
+	rawJSONType  = reflect.TypeFor[json.RawMessage]()
+)
+
+var (
+	unsafeSchemaName = regexp.MustCompile(`[^A-Za-z0-9_.-]+`)
+	// 제네릭 타입 인자에 포함된 패키지 한정자 (예: Page[github.com/acme/app/model.User])
+	typeArgPkgPath = regexp.MustCompile(`([\[,])[A-Za-z0-9_./\-]*\.`)
+)
+
+// schemaRegistry는 이름 있는 struct 타입을 components.schemas로 모으고 $ref로 참조합니다.
+type schemaRegistry struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{
+		schemas: make(map[string]*Schema),
+		names:   make(map[reflect.Type]string),
+	}
+}
+
+func (r *schemaRegistry) schemaFor(t reflect.Type) *Schema {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case durationType:
+		return &Schema{Type: "string", Format: "duration"}
+	case rawJSONType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: r.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: r.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return r.structSchema(t)
+		}
+		return &Schema{Ref: "#/components/schemas/" + r.register(t)}
+	default:
+		// interface{} 등 형태를 알 수 없는 값은 제한 없는 스키마로 둔다.
+		return &Schema{}
+	}
+}
+
+// register는 struct 타입을 컴포넌트로 등록하고 이름을 반환합니다.
+// 재귀 타입을 위해 본문을 만들기 전에 이름을 먼저 예약합니다.
+func (r *schemaRegistry) register(t reflect.Type) string {
+	if name, ok := r.names[t]; ok {
+		return name
+	}
+
+	name := r.uniqueName(t)
+	r.names[t] = name
+	r.schemas[name] = &Schema{}
+	*r.schemas[name] = *r.structSchema(t)
+	return name
+}
+
+func (r *schemaRegistry) uniqueName(t reflect.Type) string {
+	base := typeArgPkgPath.ReplaceAllString(t.Name(), "$1")
+	base = unsafeSchemaName.ReplaceAllString(base, "_")
+	base = strings.Trim(base, "_")
+	if _, taken := r.schemas[base]; !taken {
+		return base
+	}
+
+	// 다른 패키지의 같은 이름 타입은 패키지 이름을 붙여 구분한다.
+	pkg := t.PkgPath()
+	if idx := strings.LastIndex(pkg, "/"); idx >= 0 {
+		pkg = pkg[idx+1:]
+	}
+	name := unsafeSchemaName.ReplaceAllString(pkg, "_") + "." + base
+	for i := 2; ; i++ {
+		if _, taken := r.schemas[name]; !taken {
+			return name
+		}
+		name = unsafeSchemaName.ReplaceAllString(pkg, "_") + "." + base + strconv.Itoa(i)
+	}
+}
+
+func (r *schemaRegistry) structSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, skip := jsonFieldName(field)
+		if skip {
+			continue
+		}
+
+		// 태그 없는 임베디드 struct는 encoding/json처럼 필드를 펼친다.
+		if field.Anonymous && field.Tag.Get("json") == "" {
+			embedded := field.Type
+			for embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				inner := r.structSchema(embedded)
+				for key, value := range inner.Properties {
+					schema.Properties[key] = value
+				}
+				schema.Required = append(schema.Required, inner.Required...)
+				continue
+			}
+		}
+
+		fieldSchema := r.schemaFor(field.Type)
+		required := applyValidateTag(fieldSchema, field)
+		schema.Properties[name] = fieldSchema
+
+		// 필수 여부는 validate 태그의 required만으로 판단한다.
+		if required {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return schema
+}
+
+func jsonFieldName(field reflect.StructField) (string, bool) {
+	tag := field.Tag.Get("json")
+	if tag == "-" {
+		return "", true
+	}
+	name, _, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = field.Name
+	}
+	return name, false
+}
+
+// applyValidateTag는 validate 태그를 스키마 제약으로 옮기고, required 여부를 반환합니다.
+func applyValidateTag(schema *Schema, field reflect.StructField) bool {
+	tag := field.Tag.Get("validate")
+	if tag == "" || schema.Ref != "" {
+		return strings.Contains(tag, "required")
+	}
+
+	required := false
+	for _, raw := range strings.Split(tag, ",") {
+		rule, param, _ := strings.Cut(strings.TrimSpace(raw), "=")
+		switch rule {
+		case "required":
+			required = true
+		case "email":
+			schema.Format = "email"
+		case "oneof":
+			for _, option := range strings.Fields(param) {
+				schema.Enum = append(schema.Enum, enumValue(schema, option))
+			}
+		case "min", "max", "len":
+			limit, err := strconv.ParseFloat(param, 64)
+			if err != nil {
+				continue
+			}
+			applyLimit(schema, rule, limit)
+		}
+	}
+	return required
+}
+
+func applyLimit(schema *Schema, rule string, limit float64) {
+	intLimit := int(limit)
+	switch schema.Type {
+	case "string":
+		if rule == "min" || rule == "len" {
+			schema.MinLength = &intLimit
+		}
+		if rule == "max" || rule == "len" {
+			schema.MaxLength = &intLimit
+		}
+	case "array":
+		if rule == "min" || rule == "len" {
+			schema.MinItems = &intLimit
+		}
+		if rule == "max" || rule == "len" {
+			schema.MaxItems = &intLimit
+		}
+	case "integer", "number":
+		if rule == "min" {
+			schema.Minimum = &limit
+		}
+		if rule == "max" {
+			schema.Maximum = &limit
+		}
+	}
+}
+
+func enumValue(schema *Schema, raw string) any {
+	if schema.Type == "integer" {
+		if v, err := strconv.ParseInt(raw, 10, 64); err == nil {
+			return v
+		}
+	}
+	return raw
+}
@@ -1,6 +1,7 @@
 package pipeline
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -8,11 +9,13 @@ import (
 	"runtime/debug"
 
 	"github.com/NARUBROWN/spine/core"
+	"github.com/NARUBROWN/spine/internal/container"
 	"github.com/NARUBROWN/spine/internal/event/hook"
 	"github.com/NARUBROWN/spine/internal/handler"
 	"github.com/NARUBROWN/spine/internal/invoker"
 	"github.com/NARUBROWN/spine/internal/resolver"
 	"github.com/NARUBROWN/spine/internal/router"
+	"github.com/NARUBROWN/spine/internal/runtime"
 	"github.com/NARUBROWN/spine/pkg/httperr"
 	"github.com/NARUBROWN/spine/pkg/path"
 )
@@ -47,10 +50,15 @@ func (p *Pipeline) AddReturnValueHandler(handlers ...handler.ReturnValueHandler)
 
 // Execute는 하나의 요청 실행 전체를 소유합니다.
 func (p *Pipeline) Execute(ctx core.ExecutionContext) (finalErr error) {
+	// Scoped 컴포넌트는 실행 단위마다 생성되고, 모든 AfterCompletion 이후에 정리된다.
+	scope := p.openScope(ctx)
 	defer func() {
 		if recovered := recover(); recovered != nil {
 			finalErr = panicAsError(recovered)
 		}
+		if closeErr := scope.Close(finalErr); closeErr != nil {
+			finalErr = errors.Join(finalErr, closeErr)
+		}
 		if finalErr != nil {
 			p.handleExecutionError(ctx, finalErr)
 		}
@@ -77,6 +85,9 @@ func (p *Pipeline) Execute(ctx core.ExecutionContext) (finalErr error) {
 	// Router가 실행 대상을 결정
 	meta, err := p.router.Route(ctx)
 	if err != nil {
+		if p.answerOptions(ctx, err) {
+			return nil
+		}
 		return err
 	}
 	globalMeta = meta
@@ -110,7 +121,8 @@ func (p *Pipeline) Execute(ctx core.ExecutionContext) (finalErr error) {
 	}
 
 	// Controller Method 호출
-	results, err := p.invoker.Invoke(
+	results, err := p.invoker.InvokeInScope(
+		scope,
 		meta.ControllerType,
 		meta.Method,
 		args,
@@ -150,6 +162,44 @@ func (p *Pipeline) Execute(ctx core.ExecutionContext) (finalErr error) {
 	return nil
 }
 
+// answerOptions는 OPTIONS 핸들러가 없는 경로의 OPTIONS 요청에 허용 메서드를 담아 204로 응답합니다.
+// CORS Interceptor처럼 글로벌 Interceptor가 먼저 중단한 경우에는 여기까지 오지 않는다.
+func (p *Pipeline) answerOptions(ctx core.ExecutionContext, err error) bool {
+	if ctx.Method() != http.MethodOptions {
+		return false
+	}
+
+	var httpErr *httperr.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Status != http.StatusMethodNotAllowed {
+		return false
+	}
+
+	rwAny, ok := ctx.Get("spine.response_writer")
+	if !ok {
+		return false
+	}
+	rw, ok := rwAny.(core.ResponseWriter)
+	if !ok {
+		return false
+	}
+
+	for key, value := range httpErr.Headers {
+		rw.SetHeader(key, value)
+	}
+	rw.WriteStatus(http.StatusNoContent)
+	return true
+}
+
+// openScope는 실행 단위 DI 스코프를 만들고, 스코프가 제공하는 기본 값을 채운다.
+func (p *Pipeline) openScope(ctx core.ExecutionContext) *container.Scope {
+	scope := p.invoker.NewScope()
+	scope.Provide(reflect.TypeFor[core.ControllerContext](), runtime.NewControllerContext(ctx))
+	scope.Provide(reflect.TypeFor[context.Context](), ctx.Context())
+	// PostExecutionHook이 같은 실행의 Scoped 컴포넌트(예: outbox.Tx)를 쓸 수 있게 노출한다.
+	ctx.Set("spine.scope", scope)
+	return scope
+}
+
 func buildParameterMeta(method reflect.Method, pathKeys []string) []resolver.ParameterMeta {
 	pathIdx := 0
 	metas := make([]resolver.ParameterMeta, 0, method.Type.NumIn()-1)
@@ -214,8 +264,9 @@ func (p *Pipeline) handleErrorReturn(ctx core.ExecutionContext, results []any) (
 				}
 			}
 			return false, fmt.Errorf(
-				"no ReturnValueHandler can handle the error return value (%s)",
+				"no ReturnValueHandler can handle the error return value (%s): %w",
 				resultType.String(),
+				result.(error),
 			)
 		}
 	}
@@ -306,12 +357,16 @@ func (p *Pipeline) handleExecutionError(ctx core.ExecutionContext, err error) {
 
 	var httpErr *httperr.HTTPError
 	if errors.As(err, &httpErr) {
-		rw.WriteJSON(
-			httpErr.Status,
-			map[string]any{
-				"message": httpErr.Message,
-			},
-		)
+		body := map[string]any{
+			"message": httpErr.Message,
+		}
+		for key, value := range httpErr.Headers {
+			rw.SetHeader(key, value)
+		}
+		if httpErr.Details != nil {
+			body["details"] = httpErr.Details
+		}
+		rw.WriteJSON(httpErr.Status, body)
 		return
 	}
 
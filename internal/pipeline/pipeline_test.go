@@ -12,6 +12,7 @@ import (
 	"github.com/NARUBROWN/spine/internal/handler"
 	"github.com/NARUBROWN/spine/internal/invoker"
 	"github.com/NARUBROWN/spine/internal/resolver"
+	"github.com/NARUBROWN/spine/pkg/di"
 	"github.com/NARUBROWN/spine/pkg/event/publish"
 	"github.com/NARUBROWN/spine/pkg/httperr"
 	"github.com/NARUBROWN/spine/pkg/path"
@@ -554,3 +555,60 @@ func TestBuildParameterMeta_AssignsPathKeysOnlyForPathTypes(t *testing.T) {
 
 var _ hook.PostExecutionHook = (*testPostHook)(nil)
 var _ handler.ReturnValueHandler = (*testReturnHandler)(nil)
+
+type scopedUnitOfWork struct {
+	events *[]string
+}
+
+func (u *scopedUnitOfWork) Dispose(err error) error {
+	*u.events = append(*u.events, "dispose")
+	return nil
+}
+
+type scopedController struct {
+	uow *scopedUnitOfWork
+}
+
+func (c *scopedController) Handle() string {
+	*c.uow.events = append(*c.uow.events, "handle")
+	return "ok"
+}
+
+func TestExecute_ScopedControllerIsDisposedAfterCompletion(t *testing.T) {
+	events := []string{}
+	ctr := container.New()
+	_ = ctr.RegisterConstructor(di.Scoped(func() *scopedUnitOfWork {
+		return &scopedUnitOfWork{events: &events}
+	}))
+	_ = ctr.RegisterConstructor(di.Scoped(func(uow *scopedUnitOfWork) *scopedController {
+		return &scopedController{uow: uow}
+	}))
+
+	controllerType := reflect.TypeOf(&scopedController{})
+	method, _ := controllerType.MethodByName("Handle")
+	p := NewPipeline(&testRouter{meta: core.HandlerMeta{ControllerType: controllerType, Method: method}}, invoker.NewInvoker(ctr))
+	p.AddInterceptor(&testInterceptor{name: "global", events: &events})
+	p.AddReturnValueHandler(&testReturnHandler{
+		supports: func(rt reflect.Type) bool { return rt.Kind() == reflect.String },
+		handle:   func(v any, ctx core.ExecutionContext) error { return nil },
+	})
+
+	for range 2 {
+		if err := p.Execute(newTestExecutionContext()); err != nil {
+			t.Fatalf("실행에 실패했습니다: %v", err)
+		}
+	}
+
+	expected := []string{
+		"pre:global", "handle", "post:global", "after:global", "dispose",
+		"pre:global", "handle", "post:global", "after:global", "dispose",
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("예상하지 못한 이벤트입니다: %v", events)
+	}
+	for i := range expected {
+		if events[i] != expected[i] {
+			t.Fatalf("Scoped 인스턴스는 실행마다 생성되고 AfterCompletion 이후 정리되어야 합니다: %v", events)
+		}
+	}
+}
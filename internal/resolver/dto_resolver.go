@@ -32,7 +32,8 @@ func (r *DTOResolver) Supports(pm ParameterMeta) bool {
 		}
 	}
 
-	return true
+	// query 태그가 있으면 QueryDTO로 넘긴다
+	return !hasQueryTag(elem)
 }
 
 func (r *DTOResolver) Resolve(ctx core.ExecutionContext, parameterMeta ParameterMeta) (any, error) {
@@ -52,6 +53,10 @@ func (r *DTOResolver) Resolve(ctx core.ExecutionContext, parameterMeta Parameter
 		)
 	}
 
+	if err := validateDTO(valuePtr.Interface()); err != nil {
+		return nil, err
+	}
+
 	// 포인터로 전달
 	return valuePtr.Interface(), nil
 }
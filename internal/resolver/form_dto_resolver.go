@@ -41,5 +41,9 @@ func (r *FormDTOResolver) Resolve(ctx core.ExecutionContext, parameterMeta Param
 		return nil, err
 	}
 
+	if err := validateDTO(dto); err != nil {
+		return nil, err
+	}
+
 	return dto, nil
 }
@@ -15,9 +15,9 @@ func (hr *HeaderResolver) Supports(pm ParameterMeta) bool {
 }
 
 func (hr *HeaderResolver) Resolve(ctx core.ExecutionContext, parameterMeta ParameterMeta) (any, error) {
-	httpCtx, ok := ctx.(core.HttpRequestContext)
+	values, ok := ctx.(core.RequestValuesCarrier)
 	if !ok {
-		return nil, fmt.Errorf("context is not an HTTP request context")
+		return nil, fmt.Errorf("context does not carry request values")
 	}
-	return header.NewValues(httpCtx.Headers()), nil
+	return header.NewValues(values.Headers()), nil
 }
@@ -16,9 +16,9 @@ func (r *PathBooleanResolver) Supports(parameterMeta ParameterMeta) bool {
 }
 
 func (r *PathBooleanResolver) Resolve(ctx core.ExecutionContext, parameterMeta ParameterMeta) (any, error) {
-	httpCtx, ok := ctx.(core.HttpRequestContext)
+	values, ok := ctx.(core.RequestValuesCarrier)
 	if !ok {
-		return nil, fmt.Errorf("context is not an HTTP request context")
+		return nil, fmt.Errorf("context does not carry request values")
 	}
 
 	if parameterMeta.PathKey == "" {
@@ -28,7 +28,7 @@ func (r *PathBooleanResolver) Resolve(ctx core.ExecutionContext, parameterMeta P
 		)
 	}
 
-	raw, ok := httpCtx.Params()[parameterMeta.PathKey]
+	raw, ok := values.Params()[parameterMeta.PathKey]
 	if !ok {
 		return nil, fmt.Errorf(
 			"path parameter not found: %s",
@@ -16,15 +16,15 @@ func (r *PathIntResolver) Supports(parameterMeta ParameterMeta) bool {
 }
 
 func (r *PathIntResolver) Resolve(ctx core.ExecutionContext, parameterMeta ParameterMeta) (any, error) {
-	httpCtx, ok := ctx.(core.HttpRequestContext)
+	values, ok := ctx.(core.RequestValuesCarrier)
 	if !ok {
-		return nil, fmt.Errorf("context is not an HTTP request context")
+		return nil, fmt.Errorf("context does not carry request values")
 	}
 
 	if parameterMeta.PathKey == "" {
 		return nil, fmt.Errorf("no path key matches %v", parameterMeta.Type)
 	}
-	raw, ok := httpCtx.Params()[parameterMeta.PathKey]
+	raw, ok := values.Params()[parameterMeta.PathKey]
 	if !ok {
 		return nil, fmt.Errorf("path parameter not found: %s", parameterMeta.PathKey)
 	}
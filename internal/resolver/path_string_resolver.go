@@ -15,9 +15,9 @@ func (r *PathStringResolver) Supports(parameterMeta ParameterMeta) bool {
 }
 
 func (r *PathStringResolver) Resolve(ctx core.ExecutionContext, parameterMeta ParameterMeta) (any, error) {
-	httpCtx, ok := ctx.(core.HttpRequestContext)
+	values, ok := ctx.(core.RequestValuesCarrier)
 	if !ok {
-		return nil, fmt.Errorf("context is not an HTTP request context")
+		return nil, fmt.Errorf("context does not carry request values")
 	}
 
 	if parameterMeta.PathKey == "" {
@@ -27,7 +27,7 @@ func (r *PathStringResolver) Resolve(ctx core.ExecutionContext, parameterMeta Pa
 		)
 	}
 
-	raw, ok := httpCtx.Params()[parameterMeta.PathKey]
+	raw, ok := values.Params()[parameterMeta.PathKey]
 	if !ok {
 		return nil, fmt.Errorf(
 			"path parameter not found: %s",
@@ -0,0 +1,210 @@
+package resolver
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/NARUBROWN/spine/core"
+	"github.com/NARUBROWN/spine/pkg/httperr"
+)
+
+var (
+	timeType     = reflect.TypeFor[time.Time]()
+	durationType = reflect.TypeFor[time.Duration]()
+)
+
+/*
+QueryDTOResolver는 query 태그가 선언된 struct(값 또는 포인터)를 쿼리 스트링으로 채웁니다.
+반복된 키는 슬라이스로, 키가 없는 포인터 필드는 nil로 남기며,
+default 태그는 키가 없을 때 사용합니다. (슬라이스 기본값은 콤마로 구분)
+
+	type SearchQuery struct {
+		Keyword string        `query:"q"`
+		Page    int           `query:"page" default:"1"`
+		Tags    []string      `query:"tag"`
+		From    *time.Time    `query:"from"`
+		Timeout time.Duration `query:"timeout" default:"5s"`
+	}
+*/
+type QueryDTOResolver struct{}
+
+func (r *QueryDTOResolver) Supports(pm ParameterMeta) bool {
+	return hasQueryTag(pm.Type)
+}
+
+func (r *QueryDTOResolver) Resolve(ctx core.ExecutionContext, parameterMeta ParameterMeta) (any, error) {
+	httpCtx, ok := ctx.(core.HttpRequestContext)
+	if !ok {
+		return nil, fmt.Errorf("context is not an HTTP request context")
+	}
+
+	structType := parameterMeta.Type
+	if structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+
+	valuePtr := reflect.New(structType)
+	if err := bindQuery(valuePtr.Elem(), httpCtx.Queries()); err != nil {
+		return nil, err
+	}
+
+	if err := validateDTO(valuePtr.Interface()); err != nil {
+		return nil, err
+	}
+
+	if parameterMeta.Type.Kind() == reflect.Pointer {
+		return valuePtr.Interface(), nil
+	}
+	return valuePtr.Elem().Interface(), nil
+}
+
+// hasQueryTag는 struct 또는 struct 포인터에 query 태그 필드가 하나라도 있는지 확인합니다.
+func hasQueryTag(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t == timeType {
+		return false
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("query") != "" {
+			return true
+		}
+	}
+	return false
+}
+
+func bindQuery(target reflect.Value, queries map[string][]string) error {
+	structType := target.Type()
+
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		key, _, _ := strings.Cut(field.Tag.Get("query"), ",")
+		if key == "" || key == "-" || !field.IsExported() {
+			continue
+		}
+
+		raw, ok := queries[key]
+		if !ok || len(raw) == 0 {
+			def, hasDefault := field.Tag.Lookup("default")
+			if !hasDefault {
+				continue
+			}
+			raw = []string{def}
+			if field.Type.Kind() == reflect.Slice {
+				raw = strings.Split(def, ",")
+			}
+		}
+
+		if err := setQueryField(target.Field(i), raw); err != nil {
+			return &httperr.HTTPError{
+				Status:  400,
+				Message: fmt.Sprintf("Invalid query parameter '%s': %v", key, err),
+				Cause:   err,
+			}
+		}
+	}
+	return nil
+}
+
+func setQueryField(field reflect.Value, raw []string) error {
+	switch {
+	case field.Kind() == reflect.Pointer:
+		elem := reflect.New(field.Type().Elem())
+		if err := setQueryField(elem.Elem(), raw); err != nil {
+			return err
+		}
+		field.Set(elem)
+		return nil
+
+	case field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8:
+		slice := reflect.MakeSlice(field.Type(), len(raw), len(raw))
+		for i, item := range raw {
+			if err := setQueryScalar(slice.Index(i), item); err != nil {
+				return err
+			}
+		}
+		field.Set(slice)
+		return nil
+
+	default:
+		return setQueryScalar(field, raw[0])
+	}
+}
+
+func setQueryScalar(field reflect.Value, raw string) error {
+	switch field.Type() {
+	case timeType:
+		parsed, err := parseQueryTime(raw)
+		if err != nil {
+			return err
+		}
+		field.Set(reflect.ValueOf(parsed))
+		return nil
+	case durationType:
+		parsed, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("expected a duration (e.g. 5s), got %q", raw)
+		}
+		field.SetInt(int64(parsed))
+		return nil
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(raw)
+	case reflect.Bool:
+		parsed, err := parseQueryBool(raw)
+		if err != nil {
+			return err
+		}
+		field.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(raw, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("expected an integer, got %q", raw)
+		}
+		field.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(raw, 10, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("expected an unsigned integer, got %q", raw)
+		}
+		field.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(raw, field.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("expected a number, got %q", raw)
+		}
+		field.SetFloat(parsed)
+	default:
+		return fmt.Errorf("unsupported field type %s", field.Type())
+	}
+	return nil
+}
+
+func parseQueryBool(raw string) (bool, error) {
+	switch strings.ToLower(raw) {
+	case "true", "1", "yes", "y", "on":
+		return true, nil
+	case "false", "0", "no", "n", "off":
+		return false, nil
+	default:
+		return false, fmt.Errorf("expected a boolean, got %q", raw)
+	}
+}
+
+// parseQueryTime은 RFC3339 시각과 날짜(2006-01-02) 형식을 허용합니다.
+func parseQueryTime(raw string) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, raw); err == nil {
+		return parsed, nil
+	}
+	if parsed, err := time.Parse(time.DateOnly, raw); err == nil {
+		return parsed, nil
+	}
+	return time.Time{}, fmt.Errorf("expected an RFC3339 time or date (2006-01-02), got %q", raw)
+}
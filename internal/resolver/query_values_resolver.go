@@ -15,9 +15,9 @@ func (r *QueryValuesResolver) Supports(pm ParameterMeta) bool {
 }
 
 func (r *QueryValuesResolver) Resolve(ctx core.ExecutionContext, parameterMeta ParameterMeta) (any, error) {
-	httpCtx, ok := ctx.(core.HttpRequestContext)
+	values, ok := ctx.(core.RequestValuesCarrier)
 	if !ok {
-		return nil, fmt.Errorf("context is not an HTTP request context")
+		return nil, fmt.Errorf("context does not carry request values")
 	}
-	return query.NewValues(httpCtx.Queries()), nil
+	return query.NewValues(values.Queries()), nil
 }
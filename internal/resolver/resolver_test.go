@@ -7,15 +7,18 @@ import (
 	"net/http"
 	"net/textproto"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/NARUBROWN/spine/core"
 	eventpublish "github.com/NARUBROWN/spine/pkg/event/publish"
 	"github.com/NARUBROWN/spine/pkg/header"
+	"github.com/NARUBROWN/spine/pkg/httperr"
 	pkgmultipart "github.com/NARUBROWN/spine/pkg/multipart"
 	"github.com/NARUBROWN/spine/pkg/path"
 	"github.com/NARUBROWN/spine/pkg/query"
+	"github.com/NARUBROWN/spine/pkg/validate"
 )
 
 type fakeHttpCtx struct {
@@ -360,3 +363,188 @@ func TestUploadedFilesResolver_PropagatesMultipartError(t *testing.T) {
 		t.Fatalf("Multipart 에러가 전파되어야 합니다: %v", err)
 	}
 }
+
+type validatedSignup struct {
+	Name  string   `json:"name" validate:"required,min=2,max=10"`
+	Email string   `json:"email" validate:"required,email"`
+	Role  string   `json:"role" validate:"oneof=admin member"`
+	Age   int      `json:"age" validate:"min=1,max=100"`
+	Bio   *string  `json:"bio" validate:"omitempty,max=3"`
+	Tags  []string `json:"tags" validate:"max=2"`
+}
+
+type passwordForm struct {
+	Password string `form:"password" validate:"required"`
+	Confirm  string `form:"confirm"`
+}
+
+func (f *passwordForm) Validate() error {
+	if f.Password != f.Confirm {
+		return validate.FieldError{Field: "confirm", Rule: "match", Message: "must match password"}
+	}
+	return nil
+}
+
+type badTagDTO struct {
+	Name string `validate:"min=abc"`
+}
+
+func TestDTOResolver_ValidationFailureReturnsBadRequestWithDetails(t *testing.T) {
+	r := &DTOResolver{}
+	pm := ParameterMeta{Type: reflect.TypeOf(&validatedSignup{})}
+	ctx := newFakeHttpCtx()
+	ctx.bindValue = validatedSignup{Name: "k", Email: "not-an-email", Role: "guest", Age: 0, Tags: []string{"a", "b", "c"}}
+
+	_, err := r.Resolve(ctx, pm)
+	var httpErr *httperr.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Status != 400 {
+		t.Fatalf("검증 실패는 400 HTTPError여야 합니다: %v", err)
+	}
+
+	details, ok := httpErr.Details.(validate.Errors)
+	if !ok {
+		t.Fatalf("Details에 필드 에러 목록이 담겨야 합니다: %T", httpErr.Details)
+	}
+	got := map[string]string{}
+	for _, fieldErr := range details {
+		got[fieldErr.Field] = fieldErr.Rule
+	}
+	want := map[string]string{"name": "min", "email": "email", "role": "oneof", "age": "min", "tags": "max"}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("필드 에러가 잘못되었습니다. got=%v want=%v", got, want)
+	}
+}
+
+func TestDTOResolver_ValidPayloadPasses(t *testing.T) {
+	r := &DTOResolver{}
+	pm := ParameterMeta{Type: reflect.TypeOf(&validatedSignup{})}
+	ctx := newFakeHttpCtx()
+	ctx.bindValue = validatedSignup{Name: "kim", Email: "kim@example.com", Role: "admin", Age: 30}
+
+	if _, err := r.Resolve(ctx, pm); err != nil {
+		t.Fatalf("유효한 DTO는 통과해야 합니다: %v", err)
+	}
+}
+
+func TestDTOResolver_InvalidValidateTagIsNotBadRequest(t *testing.T) {
+	r := &DTOResolver{}
+	pm := ParameterMeta{Type: reflect.TypeOf(&badTagDTO{})}
+	ctx := newFakeHttpCtx()
+	ctx.bindValue = badTagDTO{Name: "kim"}
+
+	_, err := r.Resolve(ctx, pm)
+	var httpErr *httperr.HTTPError
+	if err == nil || errors.As(err, &httpErr) {
+		t.Fatalf("잘못된 태그 선언은 400이 아닌 일반 에러여야 합니다: %v", err)
+	}
+}
+
+func TestFormDTOResolver_RunsValidateMethod(t *testing.T) {
+	r := &FormDTOResolver{}
+	pm := ParameterMeta{Type: reflect.TypeOf(&passwordForm{})}
+	ctx := newFakeHttpCtx()
+	ctx.bindValue = passwordForm{Password: "secret", Confirm: "other"}
+
+	_, err := r.Resolve(ctx, pm)
+	var httpErr *httperr.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Status != 400 {
+		t.Fatalf("Validate 실패는 400 HTTPError여야 합니다: %v", err)
+	}
+	details := httpErr.Details.(validate.Errors)
+	if len(details) != 1 || details[0].Field != "confirm" || details[0].Rule != "match" {
+		t.Fatalf("Validate 에러가 필드 에러로 변환되어야 합니다: %+v", details)
+	}
+}
+
+type searchQuery struct {
+	Keyword string        `query:"q"`
+	Page    int           `query:"page" default:"1"`
+	Size    uint          `query:"size" default:"20"`
+	Exact   bool          `query:"exact"`
+	Score   float64       `query:"score"`
+	Tags    []string      `query:"tag"`
+	IDs     []int64       `query:"id" default:"1,2"`
+	From    *time.Time    `query:"from"`
+	Until   *time.Time    `query:"until"`
+	Timeout time.Duration `query:"timeout" default:"5s"`
+	Limit   *int          `query:"limit" validate:"omitempty,max=50"`
+}
+
+func TestQueryDTOResolver_BindsTypedFields(t *testing.T) {
+	r := &QueryDTOResolver{}
+	ctx := newFakeHttpCtx()
+	ctx.queries = map[string][]string{
+		"q":     {"spine"},
+		"page":  {"3"},
+		"exact": {"yes"},
+		"score": {"1.5"},
+		"tag":   {"go", "web"},
+		"from":  {"2024-01-02"},
+	}
+
+	for _, typ := range []reflect.Type{reflect.TypeFor[searchQuery](), reflect.TypeFor[*searchQuery]()} {
+		pm := ParameterMeta{Type: typ}
+		if !r.Supports(pm) {
+			t.Fatalf("query 태그가 있는 struct는 QueryDTOResolver가 지원해야 합니다: %v", typ)
+		}
+
+		val, err := r.Resolve(ctx, pm)
+		if err != nil {
+			t.Fatalf("QueryDTOResolver Resolve 실패: %v", err)
+		}
+		q, ok := val.(searchQuery)
+		if !ok {
+			q = *val.(*searchQuery)
+		}
+
+		if q.Keyword != "spine" || q.Page != 3 || q.Size != 20 || !q.Exact || q.Score != 1.5 {
+			t.Fatalf("스칼라 필드 바인딩이 잘못되었습니다: %+v", q)
+		}
+		if !reflect.DeepEqual(q.Tags, []string{"go", "web"}) || !reflect.DeepEqual(q.IDs, []int64{1, 2}) {
+			t.Fatalf("슬라이스 바인딩이 잘못되었습니다: tags=%v ids=%v", q.Tags, q.IDs)
+		}
+		if q.From == nil || !q.From.Equal(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)) || q.Until != nil {
+			t.Fatalf("포인터 시간 필드 바인딩이 잘못되었습니다: from=%v until=%v", q.From, q.Until)
+		}
+		if q.Timeout != 5*time.Second || q.Limit != nil {
+			t.Fatalf("기본값/옵셔널 바인딩이 잘못되었습니다: %+v", q)
+		}
+	}
+}
+
+func TestQueryDTOResolver_ParseErrorNamesKey(t *testing.T) {
+	r := &QueryDTOResolver{}
+	ctx := newFakeHttpCtx()
+	ctx.queries = map[string][]string{"page": {"abc"}}
+
+	_, err := r.Resolve(ctx, ParameterMeta{Type: reflect.TypeFor[searchQuery]()})
+	var httpErr *httperr.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Status != 400 {
+		t.Fatalf("파싱 실패는 400 HTTPError여야 합니다: %v", err)
+	}
+	if !strings.Contains(httpErr.Message, "page") {
+		t.Fatalf("에러 메시지에 쿼리 키가 포함되어야 합니다: %s", httpErr.Message)
+	}
+}
+
+func TestQueryDTOResolver_ValidatesAfterBinding(t *testing.T) {
+	r := &QueryDTOResolver{}
+	ctx := newFakeHttpCtx()
+	ctx.queries = map[string][]string{"limit": {"100"}}
+
+	_, err := r.Resolve(ctx, ParameterMeta{Type: reflect.TypeFor[searchQuery]()})
+	var httpErr *httperr.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Status != 400 || httpErr.Details == nil {
+		t.Fatalf("검증 실패는 상세 정보가 있는 400이어야 합니다: %v", err)
+	}
+}
+
+func TestDTOResolver_RejectsQueryTaggedStruct(t *testing.T) {
+	r := &DTOResolver{}
+	if r.Supports(ParameterMeta{Type: reflect.TypeFor[*searchQuery]()}) {
+		t.Fatal("query 태그가 있는 struct는 DTOResolver가 지원하지 않아야 합니다")
+	}
+	if (&QueryDTOResolver{}).Supports(ParameterMeta{Type: reflect.TypeFor[*dtoSample]()}) {
+		t.Fatal("query 태그가 없는 struct는 QueryDTOResolver가 지원하지 않아야 합니다")
+	}
+}
@@ -5,10 +5,15 @@ import (
 	"reflect"
 
 	"github.com/NARUBROWN/spine/core"
+	"github.com/NARUBROWN/spine/pkg/event/codec"
+	"github.com/NARUBROWN/spine/pkg/event/outbox"
 	"github.com/NARUBROWN/spine/pkg/event/publish"
 )
 
-type StdContextResolver struct{}
+type StdContextResolver struct {
+	// outbox.Append / Flush가 바로 발행할 때와 같은 Codec으로 기록하도록 ctx에 싣는다.
+	Codecs *codec.Registry
+}
 
 func (r *StdContextResolver) Supports(parameterMeta ParameterMeta) bool {
 	return parameterMeta.Type == reflect.TypeFor[context.Context]()
@@ -16,6 +21,9 @@ func (r *StdContextResolver) Supports(parameterMeta ParameterMeta) bool {
 
 func (r *StdContextResolver) Resolve(ctx core.ExecutionContext, parameterMeta ParameterMeta) (any, error) {
 	baseCtx := ctx.Context()
+	if r.Codecs != nil {
+		baseCtx = outbox.WithCodecs(baseCtx, r.Codecs)
+	}
 	bus := ctx.EventBus()
 	if bus != nil {
 		return context.WithValue(baseCtx, publish.PublisherKey, bus), nil
@@ -0,0 +1,29 @@
+package resolver
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/NARUBROWN/spine/pkg/httperr"
+	"github.com/NARUBROWN/spine/pkg/validate"
+)
+
+// validateDTO는 바인딩된 DTO를 검증하고, 실패 시 필드별 에러를 담은 400 응답 에러로 변환합니다.
+// 태그 선언 자체가 잘못된 경우는 개발자 실수이므로 일반 에러(500)로 반환합니다.
+func validateDTO(dto any) error {
+	err := validate.Struct(dto)
+	if err == nil {
+		return nil
+	}
+
+	var fieldErrs validate.Errors
+	if errors.As(err, &fieldErrs) {
+		return &httperr.HTTPError{
+			Status:  400,
+			Message: "Validation failed",
+			Cause:   err,
+			Details: fieldErrs,
+		}
+	}
+	return fmt.Errorf("DTO validation failed: %w", err)
+}
@@ -2,6 +2,7 @@ package router
 
 import (
 	"reflect"
+	"sort"
 	"strings"
 
 	"github.com/NARUBROWN/spine/core"
@@ -15,6 +16,27 @@ type RouteSpec struct {
 	Path         string
 	Handler      any
 	Interceptors []core.Interceptor
+	// OpenAPI 문서에 반영되는 라우트 설명
+	Doc RouteDoc
+}
+
+// RouteDoc는 핸들러 시그니처만으로 알 수 없는 라우트 문서 정보입니다.
+type RouteDoc struct {
+	Summary     string
+	Description string
+	Tags        []string
+	Deprecated  bool
+	// header.Values 파라미터로 읽는 헤더 이름 목록
+	Headers []string
+	// 핸들러 반환 타입 외에 추가로 문서화할 응답
+	Responses []ResponseDoc
+}
+
+// ResponseDoc는 상태 코드별 응답 문서입니다. BodyType이 nil이면 본문이 없는 응답입니다.
+type ResponseDoc struct {
+	Status      int
+	Description string
+	BodyType    reflect.Type
 }
 
 type Router interface {
@@ -23,9 +45,12 @@ type Router interface {
 
 type routeNode struct {
 	staticChildren map[string]*routeNode
-	paramChild     *routeNode
-	paramKey       string
-	meta           *core.HandlerMeta
+	// 제약 조건이 있는 파라미터 자식, 등록 순서대로 시도한다.
+	constrainedChildren []*routeNode
+	paramChild          *routeNode
+	wildcardChild       *routeNode
+	segment             Segment
+	meta                *core.HandlerMeta
 }
 
 type DefaultRouter struct {
@@ -45,7 +70,14 @@ func (r *DefaultRouter) ControllerTypes() []reflect.Type {
 	return append([]reflect.Type(nil), r.controllerTypes...)
 }
 
+// Register는 라우트를 트리에 추가합니다.
+// 경로는 ParsePattern으로 미리 검증되어 있어야 하며, 잘못된 패턴이면 panic 합니다.
 func (r *DefaultRouter) Register(method string, path string, meta core.HandlerMeta) {
+	segments, err := ParsePattern(path)
+	if err != nil {
+		panic(err)
+	}
+
 	root := r.trees[method]
 	if root == nil {
 		root = &routeNode{}
@@ -54,24 +86,8 @@ func (r *DefaultRouter) Register(method string, path string, meta core.HandlerMe
 
 	meta.PathKeys = extractPathKeys(path)
 	node := root
-	for _, seg := range splitPath(path) {
-		if isParamSegment(seg) {
-			if node.paramChild == nil {
-				node.paramChild = &routeNode{paramKey: seg[1:]}
-			}
-			node = node.paramChild
-			continue
-		}
-
-		if node.staticChildren == nil {
-			node.staticChildren = make(map[string]*routeNode)
-		}
-		child := node.staticChildren[seg]
-		if child == nil {
-			child = &routeNode{}
-			node.staticChildren[seg] = child
-		}
-		node = child
+	for _, seg := range segments {
+		node = node.child(seg)
 	}
 
 	metaCopy := meta
@@ -83,46 +99,66 @@ func (r *DefaultRouter) Register(method string, path string, meta core.HandlerMe
 	}
 }
 
-func (r *DefaultRouter) Route(ctx core.ExecutionContext) (core.HandlerMeta, error) {
-	root := r.trees[ctx.Method()]
-	if root == nil {
-		return core.HandlerMeta{}, httperr.NotFound("handler not found")
-	}
-
-	pathSegs := splitPath(ctx.Path())
-	node := root
-
-	var params map[string]string
-	var pathKeys []string
+// child는 세그먼트에 해당하는 자식 노드를 찾거나 만듭니다.
+// 파라미터 이름은 노드를 구분하지 않으므로 /users/:id 와 /users/:name/posts 는 같은 노드를 공유합니다.
+func (n *routeNode) child(seg Segment) *routeNode {
+	switch seg.Kind {
+	case WildcardSegment:
+		if n.wildcardChild == nil {
+			n.wildcardChild = &routeNode{segment: seg}
+		}
+		return n.wildcardChild
 
-	for _, seg := range pathSegs {
-		if node.staticChildren != nil {
-			if child := node.staticChildren[seg]; child != nil {
-				node = child
-				continue
-			}
+	case ParamSegment:
+		if n.paramChild == nil {
+			n.paramChild = &routeNode{segment: seg}
 		}
+		return n.paramChild
 
-		if node.paramChild == nil {
-			return core.HandlerMeta{}, httperr.NotFound("handler not found")
+	case ConstrainedSegment:
+		for _, child := range n.constrainedChildren {
+			if child.segment.Constraint == seg.Constraint {
+				return child
+			}
 		}
+		child := &routeNode{segment: seg}
+		n.constrainedChildren = append(n.constrainedChildren, child)
+		return child
 
-		if params == nil {
-			params = make(map[string]string, len(pathSegs))
+	default:
+		if n.staticChildren == nil {
+			n.staticChildren = make(map[string]*routeNode)
 		}
-		if pathKeys == nil {
-			pathKeys = make([]string, 0, len(pathSegs))
+		child := n.staticChildren[seg.Value]
+		if child == nil {
+			child = &routeNode{segment: seg}
+			n.staticChildren[seg.Value] = child
 		}
-		params[node.paramChild.paramKey] = seg
-		pathKeys = append(pathKeys, node.paramChild.paramKey)
-		node = node.paramChild
+		return child
 	}
+}
 
-	if node.meta == nil {
+func (r *DefaultRouter) Route(ctx core.ExecutionContext) (core.HandlerMeta, error) {
+	pathSegs := splitPath(ctx.Path())
+
+	node, values := r.lookup(ctx.Method(), pathSegs)
+	// HEAD 라우트가 없으면 GET 핸들러로 응답한다. (본문은 transport가 버림)
+	if node == nil && ctx.Method() == "HEAD" {
+		node, values = r.lookup("GET", pathSegs)
+	}
+	if node == nil {
+		// 경로는 있지만 메서드가 없는 경우와 경로 자체가 없는 경우를 구분한다.
+		if allowed := r.allowedMethods(pathSegs); len(allowed) > 0 {
+			return core.HandlerMeta{}, httperr.MethodNotAllowed("method not allowed", allowed...)
+		}
 		return core.HandlerMeta{}, httperr.NotFound("handler not found")
 	}
 
-	if params != nil {
+	if len(node.meta.PathKeys) > 0 {
+		params := make(map[string]string, len(values))
+		for i, key := range node.meta.PathKeys {
+			params[key] = values[i]
+		}
 		ctx.Set("spine.params", params)
 		ctx.Set("spine.pathKeys", append([]string(nil), node.meta.PathKeys...))
 	}
@@ -130,37 +166,126 @@ func (r *DefaultRouter) Route(ctx core.ExecutionContext) (core.HandlerMeta, erro
 	return *node.meta, nil
 }
 
-func matchPath(pattern string, path string) (bool, map[string]string, []string) {
-	patternSegs := splitPath(pattern)
-	pathSegs := splitPath(path)
+func (r *DefaultRouter) lookup(method string, pathSegs []string) (*routeNode, []string) {
+	root := r.trees[method]
+	if root == nil {
+		return nil, nil
+	}
+	return root.match(pathSegs, make([]string, 0, len(pathSegs)))
+}
 
-	if len(patternSegs) != len(pathSegs) {
+// allowedMethods는 경로에 매칭되는 라우트가 있는 메서드 목록을 정렬해 반환합니다.
+// GET이 있으면 HEAD를, 하나라도 있으면 OPTIONS를 함께 포함합니다.
+func (r *DefaultRouter) allowedMethods(pathSegs []string) []string {
+	seen := make(map[string]struct{})
+	for method := range r.trees {
+		if node, _ := r.lookup(method, pathSegs); node != nil {
+			seen[method] = struct{}{}
+		}
+	}
+	if len(seen) == 0 {
+		return nil
+	}
+
+	if _, ok := seen["GET"]; ok {
+		seen["HEAD"] = struct{}{}
+	}
+	seen["OPTIONS"] = struct{}{}
+
+	allowed := make([]string, 0, len(seen))
+	for method := range seen {
+		allowed = append(allowed, method)
+	}
+	sort.Strings(allowed)
+	return allowed
+}
+
+/*
+match는 남은 세그먼트를 소비하며 핸들러가 있는 노드를 찾습니다.
+각 위치에서 정적 세그먼트, 제약 조건 파라미터, 일반 파라미터, 와일드카드 순으로 시도하고,
+하위 경로에서 매칭에 실패하면 다음 후보로 되돌아갑니다.
+values에는 매칭된 파라미터 값이 경로 순서대로 쌓입니다.
+*/
+func (n *routeNode) match(segs []string, values []string) (*routeNode, []string) {
+	if len(segs) == 0 {
+		if n.meta != nil {
+			return n, values
+		}
+		return nil, values
+	}
+
+	seg := segs[0]
+	rest := segs[1:]
+
+	if child := n.staticChildren[seg]; child != nil {
+		if found, captured := child.match(rest, values); found != nil {
+			return found, captured
+		}
+	}
+
+	for _, child := range n.constrainedChildren {
+		if !child.segment.Matches(seg) {
+			continue
+		}
+		if found, captured := child.match(rest, append(values, seg)); found != nil {
+			return found, captured
+		}
+	}
+
+	if n.paramChild != nil {
+		if found, captured := n.paramChild.match(rest, append(values, seg)); found != nil {
+			return found, captured
+		}
+	}
+
+	if n.wildcardChild != nil && n.wildcardChild.meta != nil {
+		return n.wildcardChild, append(values, strings.Join(segs, "/"))
+	}
+
+	return nil, values
+}
+
+// MatchPath는 경로가 패턴에 맞는지 확인하고, 파라미터 값과 키를 경로 순서대로 반환합니다.
+func MatchPath(pattern string, path string) (bool, map[string]string, []string) {
+	patternSegs, err := ParsePattern(pattern)
+	if err != nil {
 		return false, nil, nil
 	}
+	pathSegs := splitPath(path)
 
 	var params map[string]string
 	var keys []string
 
-	for i := 0; i < len(patternSegs); i++ {
-		p := patternSegs[i]
+	for i, p := range patternSegs {
+		if i >= len(pathSegs) {
+			return false, nil, nil
+		}
+
 		v := pathSegs[i]
+		if p.Kind == WildcardSegment {
+			v = strings.Join(pathSegs[i:], "/")
+		} else if !p.Matches(v) {
+			return false, nil, nil
+		}
 
-		if isParamSegment(p) {
+		if p.Kind != StaticSegment {
 			if params == nil {
 				params = make(map[string]string, len(patternSegs))
 				keys = make([]string, 0, len(patternSegs))
 			}
-			key := p[1:]
-			params[key] = v
-			keys = append(keys, key)
-			continue
+			params[p.Key] = v
+			keys = append(keys, p.Key)
 		}
 
-		if p != v {
-			return false, nil, nil
+		if p.Kind == WildcardSegment {
+			return true, params, keys
 		}
 	}
 
+	if len(patternSegs) != len(pathSegs) {
+		return false, nil, nil
+	}
+
 	return true, params, keys
 }
 
@@ -188,7 +313,11 @@ func extractPathKeys(path string) []string {
 	segs := splitPath(path)
 	keys := make([]string, 0, len(segs))
 	for _, seg := range segs {
-		if isParamSegment(seg) {
+		switch {
+		case isParamSegment(seg):
+			key, _, _ := strings.Cut(seg[1:], "<")
+			keys = append(keys, key)
+		case strings.HasPrefix(seg, "*"):
 			keys = append(keys, seg[1:])
 		}
 	}
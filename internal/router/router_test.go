@@ -140,8 +140,8 @@ func TestRouter_RouteRequiresMethodAndPathMatch(t *testing.T) {
 	if !errors.As(err, &httpErr) {
 		t.Fatalf("httperr HTTPError가 예상됐지만 실제: %v", err)
 	}
-	if httpErr.Status != 404 {
-		t.Fatalf("NotFound 상태 코드는 404여야 합니다: %d", httpErr.Status)
+	if httpErr.Status != 405 {
+		t.Fatalf("경로는 있지만 메서드가 없으면 405여야 합니다: %d", httpErr.Status)
 	}
 }
 
@@ -183,7 +183,7 @@ func TestSplitPath(t *testing.T) {
 }
 
 func TestMatchPathWithParams(t *testing.T) {
-	ok, params, keys := matchPath("/team/:teamId/user/:userId", "/team/alpha/user/7")
+	ok, params, keys := MatchPath("/team/:teamId/user/:userId", "/team/alpha/user/7")
 	if !ok {
 		t.Fatal("매칭되어야 합니다")
 	}
@@ -196,8 +196,163 @@ func TestMatchPathWithParams(t *testing.T) {
 }
 
 func TestMatchPathMismatch(t *testing.T) {
-	ok, _, _ := matchPath("/team/:id", "/team")
+	ok, _, _ := MatchPath("/team/:id", "/team")
 	if ok {
 		t.Fatal("세그먼트 길이가 다르면 매칭되면 안 됩니다")
 	}
 }
+
+func TestRouter_RoutePrefersStaticThenConstrainedThenParamThenWildcard(t *testing.T) {
+	r := NewRouter()
+	r.Register("GET", "/files/*path", testHandlerMeta("List"))
+	r.Register("GET", "/files/:name", testHandlerMeta("Create"))
+	r.Register("GET", "/files/:id<int>", anotherHandlerMeta())
+	r.Register("GET", "/files/latest", testHandlerMeta("List"))
+
+	cases := []struct {
+		path   string
+		method string
+		key    string
+		value  string
+	}{
+		{path: "/files/latest", method: "List"},
+		{path: "/files/42", method: "Another", key: "id", value: "42"},
+		{path: "/files/readme", method: "Create", key: "name", value: "readme"},
+		{path: "/files/docs/a/b.txt", method: "List", key: "path", value: "docs/a/b.txt"},
+	}
+
+	for _, tc := range cases {
+		ctx := newTestExecutionContext("GET", tc.path)
+		meta, err := r.Route(ctx)
+		if err != nil {
+			t.Fatalf("%s: 예상하지 못한 에러입니다: %v", tc.path, err)
+		}
+		if meta.Method.Name != tc.method {
+			t.Fatalf("%s: 잘못된 핸들러가 선택되었습니다: %s", tc.path, meta.Method.Name)
+		}
+		if tc.key == "" {
+			continue
+		}
+		params := ctx.store["spine.params"].(map[string]string)
+		if params[tc.key] != tc.value {
+			t.Fatalf("%s: path 파라미터가 잘못되었습니다: %v", tc.path, params)
+		}
+	}
+}
+
+func TestRouter_RouteBacktracksWhenDeeperSegmentsDoNotMatch(t *testing.T) {
+	r := NewRouter()
+	r.Register("GET", "/users/me/settings", testHandlerMeta("List"))
+	r.Register("GET", "/users/:id/posts", testHandlerMeta("Create"))
+	r.Register("GET", "/users/:name", anotherHandlerMeta())
+
+	ctx := newTestExecutionContext("GET", "/users/me/posts")
+	meta, err := r.Route(ctx)
+	if err != nil {
+		t.Fatalf("정적 세그먼트 실패 후 파라미터로 되돌아가야 합니다: %v", err)
+	}
+	if meta.Method.Name != "Create" {
+		t.Fatalf("잘못된 핸들러가 선택되었습니다: %s", meta.Method.Name)
+	}
+	params := ctx.store["spine.params"].(map[string]string)
+	if params["id"] != "me" {
+		t.Fatalf("같은 노드를 공유해도 등록된 라우트의 key를 사용해야 합니다: %v", params)
+	}
+
+	ctx = newTestExecutionContext("GET", "/users/kim")
+	if _, err := r.Route(ctx); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if params := ctx.store["spine.params"].(map[string]string); params["name"] != "kim" {
+		t.Fatalf("path 파라미터가 잘못되었습니다: %v", params)
+	}
+}
+
+func TestRouter_ConstrainedParamRejectsMismatchedValue(t *testing.T) {
+	r := NewRouter()
+	r.Register("GET", "/posts/:slug<[a-z-]+>", testHandlerMeta("List"))
+	r.Register("GET", "/files/*path", testHandlerMeta("Create"))
+
+	if _, err := r.Route(newTestExecutionContext("GET", "/posts/Hello_World")); err == nil {
+		t.Fatal("제약 조건에 맞지 않는 값은 매칭되면 안 됩니다")
+	}
+	if _, err := r.Route(newTestExecutionContext("GET", "/posts/hello-world")); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if _, err := r.Route(newTestExecutionContext("GET", "/files")); err == nil {
+		t.Fatal("와일드카드는 최소 한 개의 세그먼트가 필요합니다")
+	}
+}
+
+func TestParsePattern(t *testing.T) {
+	segs, err := ParsePattern("/users/:id<uuid>/files/*path")
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if len(segs) != 4 || segs[1].Kind != ConstrainedSegment || segs[1].Key != "id" || segs[3].Kind != WildcardSegment {
+		t.Fatalf("세그먼트 해석 결과가 잘못되었습니다: %+v", segs)
+	}
+	if !segs[1].Matches("3f2b8c1e-0a4d-4e5f-9b6a-1c2d3e4f5a6b") || segs[1].Matches("42") {
+		t.Fatal("uuid 제약 조건 매칭이 잘못되었습니다")
+	}
+
+	for _, invalid := range []string{"/files/*path/edit", "/files/*", "/users/:id<[a-z>", "/users/:id/:id", "/users/:id<>"} {
+		if _, err := ParsePattern(invalid); err == nil {
+			t.Fatalf("잘못된 패턴은 에러여야 합니다: %s", invalid)
+		}
+	}
+}
+
+func TestMatchPathWithWildcardAndConstraint(t *testing.T) {
+	ok, params, keys := MatchPath("/repos/:id<int>/*rest", "/repos/7/tree/main")
+	if !ok {
+		t.Fatal("매칭되어야 합니다")
+	}
+	if params["id"] != "7" || params["rest"] != "tree/main" {
+		t.Fatalf("파라미터 매핑이 잘못되었습니다: %v", params)
+	}
+	if len(keys) != 2 || keys[0] != "id" || keys[1] != "rest" {
+		t.Fatalf("path key 순서가 잘못되었습니다: %v", keys)
+	}
+
+	if ok, _, _ := MatchPath("/repos/:id<int>/*rest", "/repos/abc/tree"); ok {
+		t.Fatal("제약 조건에 맞지 않으면 매칭되면 안 됩니다")
+	}
+}
+
+func TestRouter_RouteReturnsMethodNotAllowedWithAllowHeader(t *testing.T) {
+	r := NewRouter()
+	r.Register("GET", "/users/:id", testHandlerMeta("List"))
+	r.Register("POST", "/users/:id", testHandlerMeta("Create"))
+
+	_, err := r.Route(newTestExecutionContext("DELETE", "/users/1"))
+	var httpErr *httperr.HTTPError
+	if !errors.As(err, &httpErr) || httpErr.Status != 405 {
+		t.Fatalf("405 에러가 반환되어야 합니다: %v", err)
+	}
+	if allow := httpErr.Headers["Allow"]; allow != "GET, HEAD, OPTIONS, POST" {
+		t.Fatalf("Allow 헤더가 잘못되었습니다: %q", allow)
+	}
+
+	_, err = r.Route(newTestExecutionContext("DELETE", "/teams/1"))
+	if !errors.As(err, &httpErr) || httpErr.Status != 404 {
+		t.Fatalf("없는 경로는 404여야 합니다: %v", err)
+	}
+}
+
+func TestRouter_HeadFallsBackToGetHandler(t *testing.T) {
+	r := NewRouter()
+	r.Register("GET", "/users/:id", testHandlerMeta("List"))
+
+	ctx := newTestExecutionContext("HEAD", "/users/1")
+	meta, err := r.Route(ctx)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if meta.Method.Name != "List" {
+		t.Fatalf("GET 핸들러가 선택되어야 합니다: %s", meta.Method.Name)
+	}
+	if params := ctx.store["spine.params"].(map[string]string); params["id"] != "1" {
+		t.Fatalf("path 파라미터가 잘못되었습니다: %v", params)
+	}
+}
@@ -0,0 +1,146 @@
+package router
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type SegmentKind int
+
+const (
+	// /users 처럼 값이 그대로 일치해야 하는 세그먼트
+	StaticSegment SegmentKind = iota
+	// :id<int> 처럼 제약 조건을 만족해야 하는 파라미터
+	ConstrainedSegment
+	// :id 처럼 모든 값을 허용하는 파라미터
+	ParamSegment
+	// *path 처럼 남은 세그먼트 전체(1개 이상)를 받는 catch-all
+	WildcardSegment
+)
+
+// Segment는 라우트 패턴의 한 세그먼트입니다.
+type Segment struct {
+	Kind SegmentKind
+	// StaticSegment의 값
+	Value string
+	// 파라미터/와일드카드 이름
+	Key string
+	// 꺾쇠 안에 선언된 원본 제약 조건 (예: int, [a-z-]+)
+	Constraint string
+	pattern    *regexp.Regexp
+}
+
+// 내장 제약 조건 이름과 실제 정규식
+var builtinConstraints = map[string]string{
+	"int":   `-?[0-9]+`,
+	"uint":  `[0-9]+`,
+	"alpha": `[A-Za-z]+`,
+	"alnum": `[A-Za-z0-9]+`,
+	"uuid":  `[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`,
+}
+
+// 서로 매칭되는 값이 없는 내장 제약 조건 쌍 (uuid는 항상 '-'를 포함하고, alpha는 숫자를 포함하지 않는다)
+var disjointConstraints = map[[2]string]struct{}{
+	{"alpha", "int"}:  {},
+	{"alpha", "uint"}: {},
+	{"alpha", "uuid"}: {},
+	{"alnum", "uuid"}: {},
+	{"int", "uuid"}:   {},
+	{"uint", "uuid"}:  {},
+}
+
+// ConstraintsDisjoint는 두 제약 조건에 동시에 매칭되는 값이 없다고 알려져 있는지 확인합니다.
+// 정규식 제약 조건은 교집합을 계산할 수 없으므로 항상 false입니다.
+func ConstraintsDisjoint(a, b string) bool {
+	if a > b {
+		a, b = b, a
+	}
+	_, ok := disjointConstraints[[2]string{a, b}]
+	return ok
+}
+
+// Matches는 요청 경로의 세그먼트 값이 이 세그먼트에 매칭되는지 확인합니다.
+// WildcardSegment는 여러 세그먼트를 받으므로 여기서는 항상 true입니다.
+func (s Segment) Matches(value string) bool {
+	switch s.Kind {
+	case StaticSegment:
+		return s.Value == value
+	case ConstrainedSegment:
+		return s.pattern.MatchString(value)
+	default:
+		return true
+	}
+}
+
+/*
+ParsePattern은 라우트 경로를 세그먼트 목록으로 해석합니다.
+
+	/users/:id          일반 파라미터
+	/users/:id<int>     내장 제약 조건 (int, uint, alpha, alnum, uuid)
+	/posts/:slug<[a-z-]+>  정규식 제약 조건 (세그먼트 전체와 일치해야 함)
+	/files/*path        catch-all, 마지막 세그먼트에만 허용
+*/
+func ParsePattern(path string) ([]Segment, error) {
+	raw := splitPath(path)
+	segments := make([]Segment, 0, len(raw))
+	keys := make(map[string]struct{}, len(raw))
+
+	for i, seg := range raw {
+		parsed, err := parseSegment(seg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid route %q: %w", path, err)
+		}
+
+		if parsed.Kind == WildcardSegment && i != len(raw)-1 {
+			return nil, fmt.Errorf("invalid route %q: wildcard segment %q must be the last segment", path, seg)
+		}
+
+		if parsed.Kind != StaticSegment {
+			if _, dup := keys[parsed.Key]; dup {
+				return nil, fmt.Errorf("invalid route %q: duplicate path key %q", path, parsed.Key)
+			}
+			keys[parsed.Key] = struct{}{}
+		}
+		segments = append(segments, parsed)
+	}
+	return segments, nil
+}
+
+func parseSegment(seg string) (Segment, error) {
+	switch {
+	case strings.HasPrefix(seg, "*"):
+		key := seg[1:]
+		if key == "" {
+			return Segment{}, fmt.Errorf("wildcard segment requires a name (e.g. *path)")
+		}
+		return Segment{Kind: WildcardSegment, Key: key}, nil
+
+	case isParamSegment(seg):
+		key, constraint, hasConstraint := strings.Cut(seg[1:], "<")
+		if key == "" {
+			return Segment{}, fmt.Errorf("path parameter %q requires a name", seg)
+		}
+		if !hasConstraint {
+			return Segment{Kind: ParamSegment, Key: key}, nil
+		}
+
+		if !strings.HasSuffix(constraint, ">") || len(constraint) == 1 {
+			return Segment{}, fmt.Errorf("path parameter %q has a malformed constraint", seg)
+		}
+		constraint = constraint[:len(constraint)-1]
+
+		expr, ok := builtinConstraints[constraint]
+		if !ok {
+			expr = constraint
+		}
+		pattern, err := regexp.Compile("^(?:" + expr + ")$")
+		if err != nil {
+			return Segment{}, fmt.Errorf("path parameter %q has an invalid constraint: %w", seg, err)
+		}
+		return Segment{Kind: ConstrainedSegment, Key: key, Constraint: constraint, pattern: pattern}, nil
+
+	default:
+		return Segment{Kind: StaticSegment, Value: seg}, nil
+	}
+}
@@ -0,0 +1,91 @@
+package scheduler
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/NARUBROWN/spine/core"
+	"github.com/NARUBROWN/spine/internal/router"
+	"github.com/NARUBROWN/spine/pkg/schedule"
+)
+
+// JobSpec은 App.Schedule로 선언된 작업입니다. 부트스트랩 시 Registry에 등록됩니다.
+type JobSpec struct {
+	Spec    string
+	Handler any
+	Options []schedule.Option
+}
+
+type Registration struct {
+	// 라우팅과 로그에 사용하는 작업 이름
+	Name     string
+	Spec     string
+	Schedule schedule.Schedule
+	Meta     core.HandlerMeta
+	Options  schedule.Options
+}
+
+type Registry struct {
+	mu            sync.RWMutex
+	registrations []Registration
+	names         map[string]struct{}
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		registrations: make([]Registration, 0),
+		names:         make(map[string]struct{}),
+	}
+}
+
+func (r *Registry) Register(spec string, target any, opts ...schedule.Option) error {
+	if target == nil {
+		return fmt.Errorf("scheduler: target cannot be nil")
+	}
+
+	parsed, err := schedule.Parse(spec)
+	if err != nil {
+		return err
+	}
+
+	meta, err := router.NewHandlerMeta(target)
+	if err != nil {
+		return err
+	}
+
+	var options schedule.Options
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.Jitter < 0 || options.Timeout < 0 {
+		return fmt.Errorf("scheduler: jitter and timeout cannot be negative (spec=%s)", spec)
+	}
+
+	name := options.Name
+	if name == "" {
+		name = meta.ControllerType.Elem().Name() + "." + meta.Method.Name
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, exists := r.names[name]; exists {
+		return fmt.Errorf("scheduler: duplicate job name '%s' (use schedule.WithName to distinguish)", name)
+	}
+	r.names[name] = struct{}{}
+	r.registrations = append(r.registrations, Registration{
+		Name:     name,
+		Spec:     spec,
+		Schedule: parsed,
+		Meta:     meta,
+		Options:  options,
+	})
+	return nil
+}
+
+func (r *Registry) Registrations() []Registration {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	cpy := make([]Registration, len(r.registrations))
+	copy(cpy, r.registrations)
+	return cpy
+}
@@ -0,0 +1,93 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/NARUBROWN/spine/core"
+	"github.com/NARUBROWN/spine/internal/event/publish"
+)
+
+type JobRequestContextImpl struct {
+	ctx         context.Context
+	name        string
+	scheduledAt time.Time
+	eventBus    publish.EventBus
+	store       map[string]any
+}
+
+func NewRequestContext(
+	ctx context.Context,
+	name string,
+	scheduledAt time.Time,
+	eventBus publish.EventBus,
+) core.ExecutionContext {
+	return &JobRequestContextImpl{
+		ctx:         ctx,
+		name:        name,
+		scheduledAt: scheduledAt,
+		eventBus:    eventBus,
+	}
+}
+
+func (c *JobRequestContextImpl) Context() context.Context {
+	return c.ctx
+}
+
+// JobName은 실행 중인 작업 이름을 반환합니다.
+func (c *JobRequestContextImpl) JobName() string {
+	return c.name
+}
+
+// ScheduledAt은 이번 실행이 예정된 시각을 반환합니다. (Jitter 적용 전)
+func (c *JobRequestContextImpl) ScheduledAt() time.Time {
+	return c.scheduledAt
+}
+
+func (c *JobRequestContextImpl) EventBus() publish.EventBus {
+	if c.eventBus == nil {
+		c.eventBus = publish.NewEventBus()
+	}
+	return c.eventBus
+}
+
+func (c *JobRequestContextImpl) Get(key string) (any, bool) {
+	if c.store == nil {
+		return nil, false
+	}
+	v, ok := c.store[key]
+	return v, ok
+}
+
+func (c *JobRequestContextImpl) Set(key string, value any) {
+	if c.store == nil {
+		c.store = make(map[string]any)
+	}
+	c.store[key] = value
+}
+
+func (c *JobRequestContextImpl) Header(key string) string {
+	return ""
+}
+
+func (c *JobRequestContextImpl) Method() string {
+	// 예약 작업은 HTTP Method 개념이 없으며, 라우팅 구분을 위해 SCHEDULE을 사용합니다.
+	return "SCHEDULE"
+}
+
+func (c *JobRequestContextImpl) Path() string {
+	// 예약 작업 라우팅에서 Path는 작업 이름을 그대로 사용합니다.
+	return c.name
+}
+
+func (c *JobRequestContextImpl) Params() map[string]string {
+	return nil
+}
+
+func (c *JobRequestContextImpl) PathKeys() []string {
+	return nil
+}
+
+func (c *JobRequestContextImpl) Queries() map[string][]string {
+	return nil
+}
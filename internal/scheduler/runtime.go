@@ -0,0 +1,156 @@
+package scheduler
+
+import (
+	"context"
+	"log"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/NARUBROWN/spine/internal/pipeline"
+)
+
+type Runtime struct {
+	registry *Registry
+	pipeline *pipeline.Pipeline
+	stopOnce sync.Once
+	mu       sync.Mutex
+	stopped  bool
+	cancel   context.CancelFunc
+	// 예약 루프 goroutine
+	loops sync.WaitGroup
+	// 실행 중인 작업
+	runs sync.WaitGroup
+	// 종료 대기 시간을 넘긴 작업의 context를 취소한다.
+	runCtx    context.Context
+	cancelRun context.CancelFunc
+}
+
+func NewRuntime(registry *Registry, pipeline *pipeline.Pipeline) *Runtime {
+	if registry == nil {
+		panic("scheduler: registry cannot be nil")
+	}
+	if pipeline == nil {
+		panic("scheduler: pipeline cannot be nil")
+	}
+
+	runCtx, cancelRun := context.WithCancel(context.Background())
+	return &Runtime{
+		registry:  registry,
+		pipeline:  pipeline,
+		runCtx:    runCtx,
+		cancelRun: cancelRun,
+	}
+}
+
+func (r *Runtime) Start(ctx context.Context) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.stopped {
+		return
+	}
+
+	ctx, r.cancel = context.WithCancel(ctx)
+	for _, registration := range r.registry.Registrations() {
+		log.Printf("[Scheduler] Scheduling job '%s' (%s)", registration.Name, registration.Spec)
+		r.loops.Add(1)
+		go r.loop(ctx, registration)
+	}
+}
+
+// loop는 작업 하나의 다음 실행 시각을 계산해 기다렸다가 실행합니다.
+// 이전 실행이 끝나지 않았으면 이번 실행은 건너뜁니다.
+func (r *Runtime) loop(ctx context.Context, reg Registration) {
+	defer r.loops.Done()
+
+	var running atomic.Bool
+	next := reg.Schedule.Next(time.Now())
+	for {
+		if next.IsZero() {
+			log.Printf("[Scheduler] Job '%s' has no next run time. Stopping its schedule", reg.Name)
+			return
+		}
+
+		// Jitter는 이번 대기에만 더하고, 다음 실행 시각 계산에는 반영하지 않는다.
+		delay := jitter(reg.Options.Jitter)
+		timer := time.NewTimer(time.Until(next) + delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		scheduledAt := next
+		next = reg.Schedule.Next(scheduledAt)
+		// 실행이 밀려 지나간 시각은 따라잡지 않고 현재 이후의 시각부터 다시 계산한다.
+		if behind := time.Now().Add(-delay); !next.IsZero() && next.Before(behind) {
+			next = reg.Schedule.Next(behind)
+		}
+
+		if !running.CompareAndSwap(false, true) {
+			log.Printf("[Scheduler] Skipping job '%s' because the previous run is still in progress", reg.Name)
+			continue
+		}
+
+		r.runs.Add(1)
+		go func() {
+			defer r.runs.Done()
+			defer running.Store(false)
+			r.execute(reg, scheduledAt)
+		}()
+	}
+}
+
+// execute는 작업을 한 번 실행합니다. 종료 중에도 진행 중인 실행은 끝까지 기다린다.
+func (r *Runtime) execute(reg Registration, scheduledAt time.Time) {
+	ctx := r.runCtx
+	if timeout := reg.Options.Timeout; timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	reqCtx := NewRequestContext(ctx, reg.Name, scheduledAt, nil)
+	if err := r.pipeline.Execute(reqCtx); err != nil {
+		log.Printf("[Scheduler] Job '%s' failed: %v", reg.Name, err)
+	}
+}
+
+// Stop은 새 실행 예약을 멈추고, 진행 중인 작업이 끝날 때까지 기다립니다.
+// ctx가 먼저 끝나면 진행 중인 작업의 context를 취소하고 반환합니다.
+func (r *Runtime) Stop(ctx context.Context) {
+	r.stopOnce.Do(func() {
+		r.mu.Lock()
+		r.stopped = true
+		if r.cancel != nil {
+			r.cancel()
+		}
+		r.mu.Unlock()
+
+		r.loops.Wait()
+
+		drained := make(chan struct{})
+		go func() {
+			r.runs.Wait()
+			close(drained)
+		}()
+
+		select {
+		case <-drained:
+			r.cancelRun()
+			log.Printf("[Scheduler] All jobs stopped")
+		case <-ctx.Done():
+			r.cancelRun()
+			log.Printf("[Scheduler] Shutdown timed out. Cancelled running jobs: %v", ctx.Err())
+		}
+	})
+}
+
+func jitter(limit time.Duration) time.Duration {
+	if limit <= 0 {
+		return 0
+	}
+	return rand.N(limit)
+}
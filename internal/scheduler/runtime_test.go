@@ -0,0 +1,252 @@
+package scheduler
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/NARUBROWN/spine/core"
+	"github.com/NARUBROWN/spine/internal/container"
+	"github.com/NARUBROWN/spine/internal/invoker"
+	"github.com/NARUBROWN/spine/internal/pipeline"
+	"github.com/NARUBROWN/spine/internal/resolver"
+	"github.com/NARUBROWN/spine/internal/router"
+	"github.com/NARUBROWN/spine/pkg/schedule"
+)
+
+var (
+	schedulerTestRuns     atomic.Int32
+	schedulerTestRunning  atomic.Int32
+	schedulerTestOverlaps atomic.Int32
+	schedulerTestStarted  chan struct{}
+	schedulerTestRelease  chan struct{}
+	schedulerTestDone     chan error
+)
+
+type schedulerTestJob struct{}
+
+func (j *schedulerTestJob) Count() {
+	schedulerTestRuns.Add(1)
+}
+
+// Block은 동시에 실행된 횟수를 기록하고, schedulerTestRelease가 닫힐 때까지 대기합니다.
+func (j *schedulerTestJob) Block() {
+	if schedulerTestRunning.Add(1) > 1 {
+		schedulerTestOverlaps.Add(1)
+	}
+	defer schedulerTestRunning.Add(-1)
+
+	schedulerTestRuns.Add(1)
+	select {
+	case schedulerTestStarted <- struct{}{}:
+	default:
+	}
+	<-schedulerTestRelease
+}
+
+// Wait은 context가 취소될 때까지 대기합니다.
+func (j *schedulerTestJob) Wait(ctx context.Context) {
+	<-ctx.Done()
+	schedulerTestDone <- ctx.Err()
+}
+
+// scheduledAtHook은 실행마다 예정 시각을 기록합니다.
+type scheduledAtHook struct {
+	times chan time.Time
+}
+
+func (h *scheduledAtHook) AfterExecution(ctx core.ExecutionContext, result []any, err error) error {
+	if jobCtx, ok := ctx.(*JobRequestContextImpl); ok {
+		h.times <- jobCtx.ScheduledAt()
+	}
+	return nil
+}
+
+func newSchedulerRuntime(t *testing.T, target any, opts ...schedule.Option) *Runtime {
+	t.Helper()
+
+	registry := NewRegistry()
+	if err := registry.Register("@every 10ms", target, opts...); err != nil {
+		t.Fatalf("등록 실패: %v", err)
+	}
+
+	ctr := container.New()
+	if err := ctr.RegisterConstructor(func() *schedulerTestJob {
+		return &schedulerTestJob{}
+	}); err != nil {
+		t.Fatalf("생성자 등록 실패: %v", err)
+	}
+
+	jobRouter := router.NewRouter()
+	for _, reg := range registry.Registrations() {
+		jobRouter.Register("SCHEDULE", reg.Name, reg.Meta)
+	}
+	p := pipeline.NewPipeline(jobRouter, invoker.NewInvoker(ctr))
+	p.AddArgumentResolver(&resolver.StdContextResolver{})
+
+	return NewRuntime(registry, p)
+}
+
+func TestRuntime_RunsJobPeriodically(t *testing.T) {
+	schedulerTestRuns.Store(0)
+
+	runtime := newSchedulerRuntime(t, (*schedulerTestJob).Count)
+	runtime.Start(context.Background())
+	defer runtime.Stop(context.Background())
+
+	deadline := time.Now().Add(2 * time.Second)
+	for schedulerTestRuns.Load() < 3 {
+		if time.Now().After(deadline) {
+			t.Fatalf("작업이 주기적으로 실행되어야 합니다. 실행 횟수=%d", schedulerTestRuns.Load())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestRuntime_JitterDoesNotShiftSchedule(t *testing.T) {
+	registry := NewRegistry()
+	if err := registry.Register("@every 50ms", (*schedulerTestJob).Count, schedule.WithJitter(40*time.Millisecond)); err != nil {
+		t.Fatalf("등록 실패: %v", err)
+	}
+
+	ctr := container.New()
+	if err := ctr.RegisterConstructor(func() *schedulerTestJob {
+		return &schedulerTestJob{}
+	}); err != nil {
+		t.Fatalf("생성자 등록 실패: %v", err)
+	}
+
+	jobRouter := router.NewRouter()
+	for _, reg := range registry.Registrations() {
+		jobRouter.Register("SCHEDULE", reg.Name, reg.Meta)
+	}
+	hook := &scheduledAtHook{times: make(chan time.Time, 10)}
+	p := pipeline.NewPipeline(jobRouter, invoker.NewInvoker(ctr))
+	p.AddPostExecutionHook(hook)
+
+	runtime := NewRuntime(registry, p)
+	runtime.Start(context.Background())
+	defer runtime.Stop(context.Background())
+
+	var prev time.Time
+	for i := range 4 {
+		select {
+		case at := <-hook.times:
+			if i > 0 && at.Sub(prev) != 50*time.Millisecond {
+				t.Fatalf("예정 시각은 Jitter와 무관하게 주기만큼 떨어져야 합니다. 간격=%s", at.Sub(prev))
+			}
+			prev = at
+		case <-time.After(2 * time.Second):
+			t.Fatal("작업이 실행되지 않았습니다")
+		}
+	}
+}
+
+func TestRuntime_SkipsOverlappingRunsAndDrainsOnStop(t *testing.T) {
+	schedulerTestRuns.Store(0)
+	schedulerTestOverlaps.Store(0)
+	schedulerTestStarted = make(chan struct{}, 1)
+	schedulerTestRelease = make(chan struct{})
+
+	runtime := newSchedulerRuntime(t, (*schedulerTestJob).Block)
+	runtime.Start(context.Background())
+
+	select {
+	case <-schedulerTestStarted:
+	case <-time.After(2 * time.Second):
+		t.Fatal("작업이 실행되어야 합니다")
+	}
+
+	// 실행 중인 동안 여러 번의 예약 시각이 지나간다.
+	time.Sleep(50 * time.Millisecond)
+
+	stopped := make(chan struct{})
+	go func() {
+		runtime.Stop(context.Background())
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		t.Fatal("진행 중인 작업이 끝나기 전에 Stop이 반환되면 안 됩니다")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(schedulerTestRelease)
+	select {
+	case <-stopped:
+	case <-time.After(2 * time.Second):
+		t.Fatal("작업이 끝나면 Stop이 반환되어야 합니다")
+	}
+
+	if runs := schedulerTestRuns.Load(); runs != 1 {
+		t.Fatalf("이전 실행이 끝나지 않았으면 건너뛰어야 합니다. 실행 횟수=%d", runs)
+	}
+	if schedulerTestOverlaps.Load() != 0 {
+		t.Fatal("같은 작업이 동시에 실행되면 안 됩니다")
+	}
+}
+
+func TestRuntime_CancelsRunAfterTimeout(t *testing.T) {
+	schedulerTestDone = make(chan error, 1)
+
+	runtime := newSchedulerRuntime(t, (*schedulerTestJob).Wait, schedule.WithTimeout(20*time.Millisecond))
+	runtime.Start(context.Background())
+	defer runtime.Stop(context.Background())
+
+	select {
+	case err := <-schedulerTestDone:
+		if err != context.DeadlineExceeded {
+			t.Fatalf("timeout 초과 시 context가 만료되어야 합니다: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("timeout이 적용되지 않았습니다")
+	}
+}
+
+func TestRuntime_StopCancelsRunningJobsWhenDrainTimesOut(t *testing.T) {
+	schedulerTestDone = make(chan error, 1)
+
+	runtime := newSchedulerRuntime(t, (*schedulerTestJob).Wait)
+	runtime.Start(context.Background())
+	time.Sleep(30 * time.Millisecond)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	runtime.Stop(ctx)
+
+	select {
+	case err := <-schedulerTestDone:
+		if err != context.Canceled {
+			t.Fatalf("종료 대기 시간이 지나면 실행 중인 작업이 취소되어야 합니다: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("실행 중인 작업이 취소되지 않았습니다")
+	}
+}
+
+func TestRegistry_RejectsInvalidJobs(t *testing.T) {
+	registry := NewRegistry()
+
+	if err := registry.Register("not a cron", (*schedulerTestJob).Count); err == nil {
+		t.Fatal("잘못된 cron 표현식은 에러가 발생해야 합니다")
+	}
+	if err := registry.Register("@hourly", (*schedulerTestJob).Count); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if err := registry.Register("@daily", (*schedulerTestJob).Count); err == nil {
+		t.Fatal("같은 이름의 작업은 에러가 발생해야 합니다")
+	}
+	if err := registry.Register("@daily", (*schedulerTestJob).Count, schedule.WithName("daily-count")); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	names := []string{}
+	for _, reg := range registry.Registrations() {
+		names = append(names, reg.Name)
+	}
+	if len(names) != 2 || names[0] != "schedulerTestJob.Count" || names[1] != "daily-count" {
+		t.Fatalf("작업 이름이 잘못되었습니다: %v", names)
+	}
+}
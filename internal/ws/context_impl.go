@@ -2,6 +2,7 @@ package ws
 
 import (
 	"context"
+	"strings"
 	"sync"
 
 	"github.com/NARUBROWN/spine/core"
@@ -21,11 +22,14 @@ type WSExecutionContext struct {
 	mu          sync.RWMutex
 	ctx         context.Context
 	connID      string
+	method      string
 	path        string
 	messageType int
 	payload     []byte
 	eventBus    publish.EventBus
 	store       map[string]any
+	// 연결을 연 HTTP 요청 정보. Runtime 밖에서 만든 Context는 nil
+	handshake *pkgws.Handshake
 }
 
 func NewWSExecutionContext(ctx context.Context, connID string, path string, messageType int, payload []byte, eventBus publish.EventBus, sendFn func(int, []byte) error) core.WebSocketContext {
@@ -34,6 +38,7 @@ func NewWSExecutionContext(ctx context.Context, connID string, path string, mess
 	return &WSExecutionContext{
 		ctx:         ctx,
 		connID:      connID,
+		method:      "WS",
 		path:        path,
 		messageType: messageType,
 		payload:     payload,
@@ -69,7 +74,17 @@ func (w *WSExecutionContext) Get(key string) (any, bool) {
 }
 
 func (w *WSExecutionContext) Header(name string) string {
-	return ""
+	if w.handshake == nil {
+		return ""
+	}
+	return w.handshake.Header.Get(name)
+}
+
+func (w *WSExecutionContext) Headers() map[string][]string {
+	if w.handshake == nil {
+		return map[string][]string{}
+	}
+	return w.handshake.Header.Clone()
 }
 
 func (w *WSExecutionContext) MessageType() int {
@@ -77,7 +92,7 @@ func (w *WSExecutionContext) MessageType() int {
 }
 
 func (w *WSExecutionContext) Method() string {
-	return "WS"
+	return w.method
 }
 
 func (w *WSExecutionContext) Params() map[string]string {
@@ -115,7 +130,14 @@ func (w *WSExecutionContext) Payload() []byte {
 }
 
 func (w *WSExecutionContext) Queries() map[string][]string {
-	return nil
+	if w.handshake == nil {
+		return map[string][]string{}
+	}
+	queries := make(map[string][]string, len(w.handshake.Query))
+	for k, v := range w.handshake.Query {
+		queries[k] = append([]string(nil), v...)
+	}
+	return queries
 }
 
 func (w *WSExecutionContext) Set(key string, value any) {
@@ -126,3 +148,17 @@ func (w *WSExecutionContext) Set(key string, value any) {
 	}
 	w.store[key] = value
 }
+
+// attributes는 핸들러와 Interceptor가 Set한 값을 복사해 반환합니다. 프레임워크 내부 키(spine.*)는 제외합니다.
+func (w *WSExecutionContext) attributes() map[string]any {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	attrs := make(map[string]any, len(w.store))
+	for k, v := range w.store {
+		if strings.HasPrefix(k, "spine.") {
+			continue
+		}
+		attrs[k] = v
+	}
+	return attrs
+}
@@ -0,0 +1,80 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	pkgws "github.com/NARUBROWN/spine/pkg/ws"
+	"github.com/gorilla/websocket"
+)
+
+// MessageMethod는 ws.On으로 등록한 타입별 핸들러의 라우팅 메서드입니다.
+func MessageMethod(messageType string) string {
+	return "WS:TYPE:" + messageType
+}
+
+/*
+messageContext는 수신 메시지의 실행 Context를 만듭니다.
+
+ws.On으로 등록한 경로는 메시지를 Envelope로 해석해 타입별 핸들러로 라우팅하고, data를 payload로 넘긴다.
+반환한 에러는 클라이언트에 그대로 보내는 메시지이며, 해석에 성공한 frame은 함께 반환한다.
+*/
+func (r *Runtime) messageContext(
+	ctx context.Context,
+	conn *connection,
+	reg Registration,
+	messageType int,
+	payload []byte,
+	sendFn func(int, []byte) error,
+) (*WSExecutionContext, *pkgws.Frame, error) {
+	if len(reg.Routes) == 0 {
+		return r.newContext(ctx, conn, "WS", messageType, payload, sendFn), nil, nil
+	}
+
+	frame, err := reg.Options.Envelope.Decode(payload)
+	if err != nil {
+		return nil, nil, errors.New("Invalid message envelope")
+	}
+	if _, ok := reg.Routes[frame.Type]; !ok {
+		return nil, &frame, fmt.Errorf("Unknown message type: %s", frame.Type)
+	}
+
+	wsCtx := r.newContext(ctx, conn, MessageMethod(frame.Type), messageType, frame.Data, sendFn)
+	wsCtx.Set("spine.ws.frame", frame)
+	wsCtx.Set("spine.ws.envelope", reg.Options.Envelope)
+	return wsCtx, &frame, nil
+}
+
+// sendErrorFrame은 에러 본문을 보냅니다. Envelope 메시지였다면 같은 타입과 요청 ID를 담은 응답으로 보냅니다.
+func sendErrorFrame(sendFn func(int, []byte) error, reg Registration, frame *pkgws.Frame, body any) error {
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	if frame != nil {
+		encoded, err = reg.Options.Envelope.Encode(pkgws.Frame{Type: frame.Type, ID: frame.ID, Error: encoded})
+		if err != nil {
+			return err
+		}
+	}
+	return sendFn(websocket.TextMessage, encoded)
+}
+
+// acknowledge는 요청 ID가 있는 메시지에 응답을 보내지 않은 핸들러 대신 data 없는 응답(ack)을 보냅니다.
+func acknowledge(ctx *WSExecutionContext, sendFn func(int, []byte) error, reg Registration, frame *pkgws.Frame) error {
+	if frame == nil || frame.ID == "" {
+		return nil
+	}
+	if replied, _ := ctx.Get("spine.ws.replied"); replied == true {
+		return nil
+	}
+
+	encoded, err := reg.Options.Envelope.Encode(pkgws.Frame{Type: frame.Type, ID: frame.ID})
+	if err != nil {
+		return err
+	}
+	return sendFn(websocket.TextMessage, encoded)
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/NARUBROWN/spine/core"
+	pkgws "github.com/NARUBROWN/spine/pkg/ws"
+)
+
+var errorType = reflect.TypeFor[error]()
+
+/*
+FrameReturnHandler는 WebSocket 핸들러의 반환값을 같은 연결에 프레임으로 보냅니다.
+
+  - []byte는 바이너리 프레임, string은 텍스트 프레임, 그 외 값은 JSON 텍스트 프레임으로 보냅니다.
+  - ws.On으로 등록한 핸들러의 반환값은 요청과 같은 타입과 요청 ID를 담은 응답 Envelope로 보냅니다. (data는 JSON)
+*/
+type FrameReturnHandler struct{}
+
+func (h *FrameReturnHandler) Supports(returnType reflect.Type) bool {
+	// 에러 반환값은 Runtime이 에러 프레임이나 연결 종료로 처리한다.
+	return !returnType.Implements(errorType)
+}
+
+func (h *FrameReturnHandler) Handle(value any, ctx core.ExecutionContext) error {
+	if rawFrame, ok := ctx.Get("spine.ws.frame"); ok {
+		return h.reply(value, rawFrame.(pkgws.Frame), ctx)
+	}
+
+	messageType, data, err := encodeFrame(value)
+	if err != nil {
+		return err
+	}
+	if err := pkgws.Send(ctx.Context(), messageType, data); err != nil {
+		return fmt.Errorf("failed to send return value: %w", err)
+	}
+	return nil
+}
+
+func (h *FrameReturnHandler) reply(value any, frame pkgws.Frame, ctx core.ExecutionContext) error {
+	rawEnvelope, _ := ctx.Get("spine.ws.envelope")
+	envelope, ok := rawEnvelope.(pkgws.Envelope)
+	if !ok {
+		return fmt.Errorf("FrameReturnHandler: envelope is not configured")
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("FrameReturnHandler: serialization failed: %w", err)
+	}
+	encoded, err := envelope.Encode(pkgws.Frame{Type: frame.Type, ID: frame.ID, Data: data})
+	if err != nil {
+		return fmt.Errorf("FrameReturnHandler: envelope encoding failed: %w", err)
+	}
+
+	if err := pkgws.Send(ctx.Context(), pkgws.TextMessage, encoded); err != nil {
+		return fmt.Errorf("failed to send reply: %w", err)
+	}
+	// Runtime이 같은 요청에 ack를 한 번 더 보내지 않도록 표시한다.
+	ctx.Set("spine.ws.replied", true)
+	return nil
+}
+
+func encodeFrame(value any) (int, []byte, error) {
+	switch v := value.(type) {
+	case []byte:
+		return pkgws.BinaryMessage, v, nil
+	case string:
+		return pkgws.TextMessage, []byte(v), nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return 0, nil, fmt.Errorf("FrameReturnHandler: serialization failed: %w", err)
+	}
+	return pkgws.TextMessage, data, nil
+}
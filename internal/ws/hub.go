@@ -0,0 +1,162 @@
+package ws
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	pkgws "github.com/NARUBROWN/spine/pkg/ws"
+)
+
+/*
+Hub는 열린 연결과 방(room) 구성을 관리합니다. Runtime이 연결을 등록하고 해제합니다.
+OnConnect에서도 Join할 수 있도록 핸드셰이크 중인 연결을 미리 예약해 두고, 업그레이드가 끝나야 메시지를 보냅니다.
+*/
+type Hub struct {
+	mu    sync.RWMutex
+	conns map[string]*trackedConn
+	// 핸드셰이크 중인(아직 업그레이드되지 않은) 연결 ID
+	pending map[string]struct{}
+	// 방 이름 -> 연결 ID
+	rooms map[string]map[string]struct{}
+	// 연결 ID -> 방 이름 (연결 해제 시 정리용)
+	memberships map[string]map[string]struct{}
+}
+
+func NewHub() *Hub {
+	return &Hub{
+		conns:       make(map[string]*trackedConn),
+		pending:     make(map[string]struct{}),
+		rooms:       make(map[string]map[string]struct{}),
+		memberships: make(map[string]map[string]struct{}),
+	}
+}
+
+func (h *Hub) Join(connID, room string) error {
+	if room == "" {
+		return errors.New("ws: room cannot be empty")
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.memberships[connID]; !ok {
+		return fmt.Errorf("%w (conn=%s)", pkgws.ErrConnectionNotFound, connID)
+	}
+	if h.rooms[room] == nil {
+		h.rooms[room] = make(map[string]struct{})
+	}
+	h.rooms[room][connID] = struct{}{}
+	h.memberships[connID][room] = struct{}{}
+	return nil
+}
+
+func (h *Hub) Leave(connID, room string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.leave(connID, room)
+}
+
+// leave는 h.mu를 잡은 상태에서 호출합니다. 빈 방은 지웁니다.
+func (h *Hub) leave(connID, room string) {
+	if members, ok := h.rooms[room]; ok {
+		delete(members, connID)
+		if len(members) == 0 {
+			delete(h.rooms, room)
+		}
+	}
+	if rooms, ok := h.memberships[connID]; ok {
+		delete(rooms, room)
+	}
+}
+
+func (h *Hub) Broadcast(room string, msg pkgws.Message) error {
+	h.mu.RLock()
+	targets := make(map[string]*trackedConn, len(h.rooms[room]))
+	for connID := range h.rooms[room] {
+		// 핸드셰이크 중인 연결은 업그레이드 전이므로 건너뛴다.
+		if conn, ok := h.conns[connID]; ok {
+			targets[connID] = conn
+		}
+	}
+	h.mu.RUnlock()
+
+	// 느린 연결이 잠금을 오래 잡지 않도록 잠금 밖에서 보낸다.
+	var errs []error
+	for connID, conn := range targets {
+		if err := conn.send(msg.Type, msg.Data); err != nil {
+			errs = append(errs, fmt.Errorf("send to conn %s: %w", connID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (h *Hub) SendTo(connID string, msg pkgws.Message) error {
+	h.mu.RLock()
+	conn, ok := h.conns[connID]
+	h.mu.RUnlock()
+
+	if !ok {
+		return fmt.Errorf("%w (conn=%s)", pkgws.ErrConnectionNotFound, connID)
+	}
+	return conn.send(msg.Type, msg.Data)
+}
+
+func (h *Hub) Connections() []string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return slices.Sorted(maps.Keys(h.conns))
+}
+
+func (h *Hub) Members(room string) []string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return slices.Sorted(maps.Keys(h.rooms[room]))
+}
+
+func (h *Hub) Rooms(connID string) []string {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return slices.Sorted(maps.Keys(h.memberships[connID]))
+}
+
+// reserve는 OnConnect 전에 연결을 예약해, 핸드셰이크 중에도 Join할 수 있게 합니다.
+func (h *Hub) reserve(connID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.pending[connID] = struct{}{}
+	h.memberships[connID] = make(map[string]struct{})
+}
+
+// add는 업그레이드된 연결을 등록합니다. 예약 중에 들어간 방은 그대로 유지합니다.
+func (h *Hub) add(connID string, conn *trackedConn) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.pending, connID)
+	h.conns[connID] = conn
+	if h.memberships[connID] == nil {
+		h.memberships[connID] = make(map[string]struct{})
+	}
+}
+
+// remove는 연결을 지우고, 들어가 있던 모든 방에서 뺍니다.
+func (h *Hub) remove(connID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for room := range h.memberships[connID] {
+		h.leave(connID, room)
+	}
+	delete(h.memberships, connID)
+	delete(h.pending, connID)
+	delete(h.conns, connID)
+}
+
+// snapshot은 현재 열린 연결을 복사해 반환합니다.
+func (h *Hub) snapshot() map[string]*trackedConn {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return maps.Clone(h.conns)
+}
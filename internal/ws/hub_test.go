@@ -0,0 +1,91 @@
+package ws
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	pkgws "github.com/NARUBROWN/spine/pkg/ws"
+)
+
+func TestHub_JoinRequiresOpenConnection(t *testing.T) {
+	hub := NewHub()
+
+	if err := hub.Join("missing", "lobby"); !errors.Is(err, pkgws.ErrConnectionNotFound) {
+		t.Fatalf("없는 연결은 방에 들어갈 수 없어야 합니다: %v", err)
+	}
+	if err := hub.SendTo("missing", pkgws.Text("hi")); !errors.Is(err, pkgws.ErrConnectionNotFound) {
+		t.Fatalf("없는 연결에는 보낼 수 없어야 합니다: %v", err)
+	}
+
+	hub.add("c-1", &trackedConn{})
+	if err := hub.Join("c-1", ""); err == nil {
+		t.Fatal("빈 방 이름은 허용하지 않아야 합니다")
+	}
+}
+
+func TestHub_TracksRoomsAndCleansUpOnRemove(t *testing.T) {
+	hub := NewHub()
+	hub.add("c-1", &trackedConn{})
+	hub.add("c-2", &trackedConn{})
+
+	for _, join := range [][2]string{{"c-1", "lobby"}, {"c-2", "lobby"}, {"c-1", "user:42"}} {
+		if err := hub.Join(join[0], join[1]); err != nil {
+			t.Fatalf("예상하지 못한 에러입니다: %v", err)
+		}
+	}
+
+	if got := hub.Members("lobby"); !slices.Equal(got, []string{"c-1", "c-2"}) {
+		t.Fatalf("방 구성원이 잘못되었습니다: %v", got)
+	}
+	if got := hub.Rooms("c-1"); !slices.Equal(got, []string{"lobby", "user:42"}) {
+		t.Fatalf("연결의 방 목록이 잘못되었습니다: %v", got)
+	}
+
+	hub.Leave("c-2", "lobby")
+	if got := hub.Members("lobby"); !slices.Equal(got, []string{"c-1"}) {
+		t.Fatalf("Leave 후 방에서 빠져야 합니다: %v", got)
+	}
+
+	hub.remove("c-1")
+	if got := hub.Connections(); !slices.Equal(got, []string{"c-2"}) {
+		t.Fatalf("연결 목록이 잘못되었습니다: %v", got)
+	}
+	if len(hub.rooms) != 0 || len(hub.Rooms("c-1")) != 0 {
+		t.Fatalf("끊어진 연결은 모든 방에서 빠지고 빈 방은 지워져야 합니다: %v", hub.rooms)
+	}
+}
+
+func TestHub_ReservedConnectionCanJoinBeforeUpgrade(t *testing.T) {
+	hub := NewHub()
+	hub.reserve("c-1")
+
+	if err := hub.Join("c-1", "lobby"); err != nil {
+		t.Fatalf("예약된 연결은 방에 들어갈 수 있어야 합니다: %v", err)
+	}
+	if len(hub.Connections()) != 0 {
+		t.Fatalf("업그레이드 전 연결은 연결 목록에 없어야 합니다: %v", hub.Connections())
+	}
+	// 업그레이드 전에는 보낼 연결이 없으므로 건너뛴다.
+	if err := hub.Broadcast("lobby", pkgws.Text("hi")); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if err := hub.SendTo("c-1", pkgws.Text("hi")); !errors.Is(err, pkgws.ErrConnectionNotFound) {
+		t.Fatalf("업그레이드 전 연결에는 보낼 수 없어야 합니다: %v", err)
+	}
+
+	hub.add("c-1", &trackedConn{})
+	if got := hub.Rooms("c-1"); !slices.Equal(got, []string{"lobby"}) {
+		t.Fatalf("예약 중에 들어간 방은 유지되어야 합니다: %v", got)
+	}
+
+	hub.reserve("c-2")
+	_ = hub.Join("c-2", "lobby")
+	hub.remove("c-2")
+	if got := hub.Members("lobby"); !slices.Equal(got, []string{"c-1"}) {
+		t.Fatalf("거절된 연결은 방에서 빠져야 합니다: %v", got)
+	}
+	if len(hub.pending) != 0 {
+		t.Fatalf("예약이 정리되어야 합니다: %v", hub.pending)
+	}
+}
@@ -0,0 +1,130 @@
+package ws
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"net/http"
+
+	"github.com/NARUBROWN/spine/internal/router"
+	"github.com/NARUBROWN/spine/pkg/httperr"
+	pkgws "github.com/NARUBROWN/spine/pkg/ws"
+	"github.com/gorilla/websocket"
+)
+
+// 생명주기 핸들러의 라우팅 메서드. 메시지 핸들러는 "WS"로 라우팅한다.
+const (
+	MethodConnect    = "WS:CONNECT"
+	MethodDisconnect = "WS:DISCONNECT"
+)
+
+// connection은 연결 단위로 유지되는 정보입니다.
+type connection struct {
+	id        string
+	path      string
+	pathKeys  []string
+	handshake pkgws.Handshake
+	// OnConnect에서 Set한 값. 메시지마다 새 Context에 복사한다.
+	attrs map[string]any
+}
+
+// newConnection은 핸드셰이크 요청의 경로 파라미터, 헤더, 쿼리, 쿠키를 한 번 담아 둡니다.
+func newConnection(req *http.Request, reg Registration) *connection {
+	_, params, keys := router.MatchPath(reg.Path, req.URL.Path)
+	if params == nil {
+		params = map[string]string{}
+	}
+
+	return &connection{
+		id:       generateConnID(),
+		path:     req.URL.Path,
+		pathKeys: keys,
+		handshake: pkgws.Handshake{
+			Params:     params,
+			Header:     req.Header.Clone(),
+			Query:      req.URL.Query(),
+			Cookies:    req.Cookies(),
+			RemoteAddr: req.RemoteAddr,
+		},
+	}
+}
+
+func (r *Runtime) newContext(ctx context.Context, conn *connection, method string, messageType int, payload []byte, sendFn func(int, []byte) error) *WSExecutionContext {
+	wsCtx := NewWSExecutionContext(ctx, conn.id, conn.path, messageType, payload, nil, sendFn).(*WSExecutionContext)
+	wsCtx.method = method
+	wsCtx.handshake = &conn.handshake
+	wsCtx.Set("spine.ws.handshake", conn.handshake)
+	if len(conn.pathKeys) > 0 {
+		wsCtx.Set("spine.params", conn.handshake.Params)
+		wsCtx.Set("spine.pathKeys", conn.pathKeys)
+	}
+	for k, v := range conn.attrs {
+		wsCtx.Set(k, v)
+	}
+	return wsCtx
+}
+
+// connect는 업그레이드 전에 OnConnect 핸들러를 실행합니다. 거절하면 HTTP 응답을 쓰고 false를 반환합니다.
+func (r *Runtime) connect(w http.ResponseWriter, req *http.Request, reg Registration, conn *connection) bool {
+	if reg.OnConnect == nil {
+		return true
+	}
+
+	ctx := r.newContext(req.Context(), conn, MethodConnect, 0, nil, sendBeforeOpen)
+	if err := r.pipeline.Execute(ctx); err != nil {
+		log.Printf("[WS] Connection rejected (path=%s): %v", reg.Path, err)
+		writeRejection(w, err)
+		return false
+	}
+
+	conn.attrs = ctx.attributes()
+	return true
+}
+
+// disconnect는 연결이 끊어진 뒤 OnDisconnect 핸들러를 실행합니다.
+func (r *Runtime) disconnect(ctx context.Context, reg Registration, conn *connection, info pkgws.CloseInfo) {
+	if reg.OnDisconnect == nil {
+		return
+	}
+
+	wsCtx := r.newContext(ctx, conn, MethodDisconnect, 0, nil, sendAfterClose)
+	wsCtx.Set("spine.ws.close", info)
+	if err := r.pipeline.Execute(wsCtx); err != nil {
+		log.Printf("[WS] OnDisconnect failed (conn=%s): %v", conn.id, err)
+	}
+}
+
+func sendBeforeOpen(int, []byte) error {
+	return errors.New("ws: cannot send before the connection is established")
+}
+
+func sendAfterClose(int, []byte) error {
+	return errors.New("ws: cannot send after the connection is closed")
+}
+
+// writeRejection은 OnConnect 에러를 HTTP 에러 응답으로 씁니다. HTTPError가 아니면 403으로 응답합니다.
+func writeRejection(w http.ResponseWriter, err error) {
+	status, message := http.StatusForbidden, "Forbidden"
+
+	var httpErr *httperr.HTTPError
+	if errors.As(err, &httpErr) {
+		status, message = httpErr.Status, httpErr.Message
+		for key, value := range httpErr.Headers {
+			w.Header().Set(key, value)
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{"message": message})
+}
+
+// closeInfoFromError는 읽기 에러에서 종료 코드와 사유를 꺼냅니다.
+func closeInfoFromError(err error) pkgws.CloseInfo {
+	var closeErr *websocket.CloseError
+	if errors.As(err, &closeErr) {
+		return pkgws.CloseInfo{Code: closeErr.Code, Reason: closeErr.Text}
+	}
+	return pkgws.CloseInfo{Code: pkgws.CloseAbnormalClosure}
+}
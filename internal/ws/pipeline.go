@@ -0,0 +1,95 @@
+package ws
+
+import (
+	"github.com/NARUBROWN/spine/core"
+	"github.com/NARUBROWN/spine/internal/container"
+	"github.com/NARUBROWN/spine/internal/event/hook"
+	"github.com/NARUBROWN/spine/internal/invoker"
+	"github.com/NARUBROWN/spine/internal/pipeline"
+	"github.com/NARUBROWN/spine/internal/resolver"
+	spinerouter "github.com/NARUBROWN/spine/internal/router"
+	wshandler "github.com/NARUBROWN/spine/internal/ws/handler"
+	wsresolver "github.com/NARUBROWN/spine/internal/ws/resolver"
+	"github.com/NARUBROWN/spine/pkg/event/codec"
+)
+
+/*
+NewPipeline은 등록된 WebSocket 핸들러를 실행하는 파이프라인을 만듭니다.
+
+  - OnConnect, 메시지, OnDisconnect 핸들러는 같은 경로를 메서드로 구분해 라우팅합니다.
+  - interceptors는 등록마다 적용할 Interceptor를 반환하며, nil이면 등록 옵션의 Interceptor를 그대로 사용합니다.
+  - dispatchHook이 nil이 아니면 핸들러 실행 후 이벤트 발행 Hook으로 추가합니다.
+*/
+func NewPipeline(
+	c *container.Container,
+	registry *Registry,
+	interceptors func(reg Registration) ([]core.Interceptor, error),
+	dispatchHook hook.PostExecutionHook,
+	codecs *codec.Registry,
+) (*pipeline.Pipeline, error) {
+	wsRouter := spinerouter.NewRouter()
+	for _, reg := range registry.Registrations() {
+		routeInterceptors := reg.Options.Interceptors
+		if interceptors != nil {
+			resolved, err := interceptors(reg)
+			if err != nil {
+				return nil, err
+			}
+			routeInterceptors = resolved
+		}
+
+		type wsRoute struct {
+			method string
+			meta   *core.HandlerMeta
+		}
+		routes := []wsRoute{
+			{MethodConnect, reg.OnConnect},
+			{MethodDisconnect, reg.OnDisconnect},
+		}
+		if reg.Meta.ControllerType != nil {
+			routes = append(routes, wsRoute{"WS", &reg.Meta})
+		}
+		for messageType, meta := range reg.Routes {
+			routes = append(routes, wsRoute{MessageMethod(messageType), &meta})
+		}
+		for _, route := range routes {
+			if route.meta == nil {
+				continue
+			}
+			meta := *route.meta
+			meta.Interceptors = routeInterceptors
+			wsRouter.Register(route.method, reg.Path, meta)
+		}
+	}
+
+	wsPipeline := pipeline.NewPipeline(wsRouter, invoker.NewInvoker(c))
+
+	if dispatchHook != nil {
+		wsPipeline.AddPostExecutionHook(dispatchHook)
+	}
+
+	wsPipeline.AddArgumentResolver(
+		&resolver.StdContextResolver{Codecs: codecs},
+		// Attributes도 Get을 가지므로 ControllerContextResolver보다 먼저 둔다.
+		&wsresolver.AttributesResolver{},
+		&resolver.ControllerContextResolver{},
+		&wsresolver.ConnectionIDResolver{},
+		&wsresolver.HandshakeResolver{},
+		&wsresolver.CloseInfoResolver{},
+
+		// 핸드셰이크 요청의 경로 파라미터, 쿼리, 헤더
+		&resolver.HeaderResolver{},
+		&resolver.PathIntResolver{},
+		&resolver.PathStringResolver{},
+		&resolver.PathBooleanResolver{},
+		&resolver.QueryValuesResolver{},
+
+		&wsresolver.PayloadResolver{},
+		&wsresolver.DTOResolver{},
+	)
+
+	// 핸들러의 반환값은 같은 연결에 프레임(ws.On 핸들러는 응답 Envelope)으로 보낸다.
+	wsPipeline.AddReturnValueHandler(&wshandler.FrameReturnHandler{})
+
+	return wsPipeline, nil
+}
@@ -6,11 +6,19 @@ import (
 
 	"github.com/NARUBROWN/spine/core"
 	"github.com/NARUBROWN/spine/internal/router"
+	pkgws "github.com/NARUBROWN/spine/pkg/ws"
 )
 
 type Registration struct {
 	Path string
+	// ws.On으로 타입별 핸들러를 등록한 경로는 비어 있다. (ControllerType == nil)
 	Meta core.HandlerMeta
+	// 메시지 타입 -> 핸들러
+	Routes map[string]core.HandlerMeta
+	// 지정하지 않은 생명주기 핸들러는 nil
+	OnConnect    *core.HandlerMeta
+	OnDisconnect *core.HandlerMeta
+	Options      pkgws.Options
 }
 
 type Registry struct {
@@ -24,28 +32,103 @@ func NewRegistry() *Registry {
 	}
 }
 
-func (r *Registry) Register(path string, handler any) error {
+// Register는 경로에 메시지 핸들러를 등록합니다.
+// Register(path, ws.On(...), ws.On(...))처럼 핸들러 대신 ws.On으로 타입별 핸들러만 지정할 수도 있습니다.
+func (r *Registry) Register(path string, handler any, opts ...pkgws.Option) error {
 	if path == "" {
 		return fmt.Errorf("ws: path cannot be empty")
 	}
-	if handler == nil {
+	if opt, ok := handler.(pkgws.Option); ok {
+		opts = append([]pkgws.Option{opt}, opts...)
+		handler = nil
+	}
+
+	var options pkgws.Options
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	if handler == nil && len(options.Routes) == 0 {
 		return fmt.Errorf("ws: handler cannot be nil")
 	}
+	if handler != nil && len(options.Routes) > 0 {
+		return fmt.Errorf("ws: use either a handler or ws.On routes (path=%s)", path)
+	}
 
-	meta, err := router.NewHandlerMeta(handler)
+	var meta core.HandlerMeta
+	if handler != nil {
+		var err error
+		if meta, err = router.NewHandlerMeta(handler); err != nil {
+			return err
+		}
+	}
+
+	routes, err := messageRoutes(options.Routes)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w (path=%s)", err, path)
+	}
+	if len(routes) > 0 && options.Envelope == nil {
+		options.Envelope = pkgws.DefaultEnvelope
+	}
+
+	onConnect, err := lifecycleMeta(options.OnConnect)
+	if err != nil {
+		return fmt.Errorf("ws: invalid OnConnect handler (path=%s): %w", path, err)
+	}
+	onDisconnect, err := lifecycleMeta(options.OnDisconnect)
+	if err != nil {
+		return fmt.Errorf("ws: invalid OnDisconnect handler (path=%s): %w", path, err)
 	}
 
 	r.mu.Lock()
 	defer r.mu.Unlock()
 	r.registrations = append(r.registrations, Registration{
-		Path: path,
-		Meta: meta,
+		Path:         path,
+		Meta:         meta,
+		Routes:       routes,
+		OnConnect:    onConnect,
+		OnDisconnect: onDisconnect,
+		Options:      options,
 	})
 	return nil
 }
 
+func messageRoutes(routes []pkgws.Route) (map[string]core.HandlerMeta, error) {
+	if len(routes) == 0 {
+		return nil, nil
+	}
+
+	metas := make(map[string]core.HandlerMeta, len(routes))
+	for _, route := range routes {
+		if route.Type == "" {
+			return nil, fmt.Errorf("ws: message type cannot be empty")
+		}
+		if route.Handler == nil {
+			return nil, fmt.Errorf("ws: handler for message type '%s' cannot be nil", route.Type)
+		}
+		if _, exists := metas[route.Type]; exists {
+			return nil, fmt.Errorf("ws: duplicate handler for message type '%s'", route.Type)
+		}
+		meta, err := router.NewHandlerMeta(route.Handler)
+		if err != nil {
+			return nil, fmt.Errorf("ws: invalid handler for message type '%s': %w", route.Type, err)
+		}
+		metas[route.Type] = meta
+	}
+	return metas, nil
+}
+
+func lifecycleMeta(handler any) (*core.HandlerMeta, error) {
+	if handler == nil {
+		return nil, nil
+	}
+	meta, err := router.NewHandlerMeta(handler)
+	if err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
 func (r *Registry) Registrations() []Registration {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
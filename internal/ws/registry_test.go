@@ -1,6 +1,10 @@
 package ws
 
-import "testing"
+import (
+	"testing"
+
+	pkgws "github.com/NARUBROWN/spine/pkg/ws"
+)
 
 type registryTestController struct{}
 
@@ -41,3 +45,59 @@ func TestRegistry_RegisterReturnsErrorOnInvalidInput(t *testing.T) {
 		t.Fatal("nil handler는 에러여야 합니다")
 	}
 }
+
+func (c *registryTestController) OnConnect() error { return nil }
+
+func TestRegistry_RegisterLifecycleHandlers(t *testing.T) {
+	registry := NewRegistry()
+
+	if err := registry.Register(
+		"/ws/echo",
+		(*registryTestController).Handle,
+		pkgws.OnConnect((*registryTestController).OnConnect),
+	); err != nil {
+		t.Fatalf("등록 실패: %v", err)
+	}
+
+	got := registry.Registrations()[0]
+	if got.OnConnect == nil || got.OnConnect.Method.Name != "OnConnect" {
+		t.Fatalf("OnConnect 핸들러가 등록되어야 합니다: %+v", got.OnConnect)
+	}
+	if got.OnDisconnect != nil {
+		t.Fatalf("지정하지 않은 OnDisconnect는 nil이어야 합니다: %+v", got.OnDisconnect)
+	}
+
+	if err := registry.Register("/ws/bad", (*registryTestController).Handle, pkgws.OnDisconnect(func() {})); err == nil {
+		t.Fatal("메서드 표현식이 아닌 OnDisconnect는 에러여야 합니다")
+	}
+}
+
+func TestRegistry_RegisterMessageTypeRoutes(t *testing.T) {
+	registry := NewRegistry()
+
+	if err := registry.Register(
+		"/ws/chat",
+		pkgws.On("join", (*registryTestController).Handle),
+		pkgws.On("leave", (*registryTestController).OnConnect),
+	); err != nil {
+		t.Fatalf("등록 실패: %v", err)
+	}
+
+	got := registry.Registrations()[0]
+	if got.Meta.ControllerType != nil {
+		t.Fatalf("ws.On만 지정하면 기본 핸들러는 비어 있어야 합니다: %+v", got.Meta)
+	}
+	if got.Routes["join"].Method.Name != "Handle" || got.Routes["leave"].Method.Name != "OnConnect" {
+		t.Fatalf("타입별 핸들러가 잘못 등록되었습니다: %+v", got.Routes)
+	}
+	if got.Options.Envelope == nil {
+		t.Fatal("Envelope를 지정하지 않으면 기본 Envelope를 사용해야 합니다")
+	}
+
+	if err := registry.Register("/ws/dup", pkgws.On("join", (*registryTestController).Handle), pkgws.On("join", (*registryTestController).Handle)); err == nil {
+		t.Fatal("같은 타입을 두 번 등록하면 에러여야 합니다")
+	}
+	if err := registry.Register("/ws/both", (*registryTestController).Handle, pkgws.On("join", (*registryTestController).Handle)); err == nil {
+		t.Fatal("핸들러와 ws.On을 함께 지정하면 에러여야 합니다")
+	}
+}
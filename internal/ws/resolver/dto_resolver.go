@@ -7,6 +7,7 @@ import (
 
 	"github.com/NARUBROWN/spine/core"
 	"github.com/NARUBROWN/spine/internal/resolver"
+	"github.com/NARUBROWN/spine/pkg/validate"
 )
 
 type DTOResolver struct{}
@@ -31,5 +32,9 @@ func (r *DTOResolver) Resolve(ctx core.ExecutionContext, meta resolver.Parameter
 		return nil, fmt.Errorf("DTO deserialization failed: %w", err)
 	}
 
+	if err := validate.Struct(dtoPtr.Interface()); err != nil {
+		return nil, fmt.Errorf("DTO validation failed: %w", err)
+	}
+
 	return dtoPtr.Elem().Interface(), nil
 }
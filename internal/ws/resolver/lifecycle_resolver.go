@@ -0,0 +1,68 @@
+package resolver
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/NARUBROWN/spine/core"
+	"github.com/NARUBROWN/spine/internal/resolver"
+	pkgws "github.com/NARUBROWN/spine/pkg/ws"
+)
+
+// HandshakeResolver는 연결을 연 HTTP 요청 정보를 주입합니다.
+type HandshakeResolver struct{}
+
+func (r *HandshakeResolver) Supports(meta resolver.ParameterMeta) bool {
+	return meta.Type == reflect.TypeFor[pkgws.Handshake]()
+}
+
+func (r *HandshakeResolver) Resolve(ctx core.ExecutionContext, meta resolver.ParameterMeta) (any, error) {
+	raw, ok := ctx.Get("spine.ws.handshake")
+	if !ok {
+		return nil, fmt.Errorf("handshake is not available in this context")
+	}
+	return raw.(pkgws.Handshake), nil
+}
+
+// CloseInfoResolver는 OnDisconnect 핸들러에 연결 종료 정보를 주입합니다.
+type CloseInfoResolver struct{}
+
+func (r *CloseInfoResolver) Supports(meta resolver.ParameterMeta) bool {
+	return meta.Type == reflect.TypeFor[pkgws.CloseInfo]()
+}
+
+func (r *CloseInfoResolver) Resolve(ctx core.ExecutionContext, meta resolver.ParameterMeta) (any, error) {
+	raw, ok := ctx.Get("spine.ws.close")
+	if !ok {
+		return nil, fmt.Errorf("ws.CloseInfo is only available in OnDisconnect handlers")
+	}
+	return raw.(pkgws.CloseInfo), nil
+}
+
+// AttributesResolver는 연결 속성을 읽고 쓰는 ws.Attributes를 주입합니다.
+type AttributesResolver struct{}
+
+func (r *AttributesResolver) Supports(meta resolver.ParameterMeta) bool {
+	return meta.Type == reflect.TypeFor[pkgws.Attributes]()
+}
+
+func (r *AttributesResolver) Resolve(ctx core.ExecutionContext, meta resolver.ParameterMeta) (any, error) {
+	if _, ok := ctx.(core.WebSocketContext); !ok {
+		return nil, fmt.Errorf("context is not a WebSocketContext")
+	}
+	return contextAttributes{ctx: ctx}, nil
+}
+
+// contextAttributes는 실행 Context의 저장소를 그대로 사용합니다.
+// OnConnect가 끝나면 Runtime이 저장소의 값을 연결 속성으로 옮긴다.
+type contextAttributes struct {
+	ctx core.ExecutionContext
+}
+
+func (a contextAttributes) Set(key string, value any) {
+	a.ctx.Set(key, value)
+}
+
+func (a contextAttributes) Get(key string) (any, bool) {
+	return a.ctx.Get(key)
+}
@@ -125,3 +125,33 @@ func TestDTOResolver(t *testing.T) {
 		t.Fatal("잘못된 JSON이면 에러여야 합니다")
 	}
 }
+
+func TestLifecycleResolvers(t *testing.T) {
+	ctx := newTestWSContext(nil)
+	ctx.Set("spine.ws.close", pkgws.CloseInfo{Code: pkgws.CloseGoingAway, Reason: "bye"})
+
+	closeMeta := internalresolver.ParameterMeta{Type: reflect.TypeFor[pkgws.CloseInfo]()}
+	val, err := (&CloseInfoResolver{}).Resolve(ctx, closeMeta)
+	if err != nil {
+		t.Fatalf("CloseInfoResolver 실패: %v", err)
+	}
+	if info := val.(pkgws.CloseInfo); info.Code != pkgws.CloseGoingAway || info.Reason != "bye" {
+		t.Fatalf("CloseInfo 값이 잘못되었습니다: %+v", info)
+	}
+	if _, err := (&CloseInfoResolver{}).Resolve(newTestWSContext(nil), closeMeta); err == nil {
+		t.Fatal("OnDisconnect가 아니면 에러여야 합니다")
+	}
+
+	attrsMeta := internalresolver.ParameterMeta{Type: reflect.TypeFor[pkgws.Attributes]()}
+	if !(&AttributesResolver{}).Supports(attrsMeta) {
+		t.Fatal("AttributesResolver가 ws.Attributes를 지원해야 합니다")
+	}
+	val, err = (&AttributesResolver{}).Resolve(ctx, attrsMeta)
+	if err != nil {
+		t.Fatalf("AttributesResolver 실패: %v", err)
+	}
+	val.(pkgws.Attributes).Set("user", "kim")
+	if got, _ := ctx.Get("user"); got != "kim" {
+		t.Fatalf("Attributes.Set은 Context에 저장되어야 합니다: %v", got)
+	}
+}
@@ -2,15 +2,20 @@ package ws
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net/http"
 	"net/url"
 	"strings"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"github.com/NARUBROWN/spine/internal/pipeline"
 	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/httperr"
+	"github.com/NARUBROWN/spine/pkg/validate"
+	pkgws "github.com/NARUBROWN/spine/pkg/ws"
 	"github.com/gorilla/websocket"
 )
 
@@ -37,13 +42,20 @@ type Runtime struct {
 	stopOnce sync.Once
 	ctx      context.Context
 	cancel   context.CancelFunc
-	connMu   sync.Mutex
-	conns    map[string]*trackedConn
+	// 종료와 새 연결 등록이 엇갈리지 않도록 직렬화한다.
+	connMu sync.Mutex
+	hub    *Hub
 }
 
 type trackedConn struct {
 	conn *websocket.Conn
 	mu   sync.Mutex
+	// 핸들러 밖(Hub)에서 보낼 때 사용하는 쓰기 제한 시간
+	writeTimeout time.Duration
+}
+
+func (c *trackedConn) send(messageType int, data []byte) error {
+	return c.writeMessage(messageType, data, c.writeTimeout)
 }
 
 func (c *trackedConn) writeMessage(messageType int, data []byte, timeout time.Duration) error {
@@ -82,10 +94,20 @@ func NewRuntime(registry *Registry, pipeline *pipeline.Pipeline, opts boot.WebSo
 		options:  normalizeWebSocketOptions(opts),
 		ctx:      ctx,
 		cancel:   cancel,
-		conns:    make(map[string]*trackedConn),
+		hub:      NewHub(),
 	}
 }
 
+// SetHub는 연결을 관리할 Hub를 지정합니다. 연결을 받기 전에 호출해야 합니다.
+func (r *Runtime) SetHub(hub *Hub) {
+	r.hub = hub
+}
+
+// Hub는 이 Runtime의 연결을 관리하는 Hub를 반환합니다.
+func (r *Runtime) Hub() *Hub {
+	return r.hub
+}
+
 func normalizeWebSocketOptions(opts boot.WebSocketOptions) normalizedWebSocketOptions {
 	normalized := normalizedWebSocketOptions{
 		AllowedOrigins:   append([]string(nil), opts.AllowedOrigins...),
@@ -147,26 +169,61 @@ func (r *Runtime) HandleConn(w http.ResponseWriter, req *http.Request, reg Regis
 	default:
 	}
 
+	// OnConnect의 부수 효과가 허용되지 않은 Origin(CSWSH)이나 일반 HTTP 요청으로 일어나지 않도록,
+	// 업그레이드할 수 없는 요청은 OnConnect 전에 거절한다.
+	if !websocket.IsWebSocketUpgrade(req) {
+		log.Printf("[WS] Rejected non-WebSocket request (%s)", reg.Path)
+		http.Error(w, "websocket: the client is not using the websocket protocol", http.StatusBadRequest)
+		return
+	}
+	if !isAllowedWebSocketOrigin(req, r.options.AllowedOrigins) {
+		log.Printf("[WS] Rejected origin %q (%s)", req.Header.Get("Origin"), reg.Path)
+		http.Error(w, "websocket: request origin not allowed", http.StatusForbidden)
+		return
+	}
+
+	state := newConnection(req, reg)
+	connID := state.id
+	// OnConnect에서 Hub.Join을 호출할 수 있도록 연결을 먼저 예약한다.
+	r.hub.reserve(connID)
+	if !r.connect(w, req, reg, state) {
+		r.untrackConn(connID)
+		return
+	}
+
+	// OnConnect가 수락한 뒤 연결을 맺지 못하면, OnConnect와 짝이 맞도록 OnDisconnect를 실행한다.
+	abort := func() {
+		r.untrackConn(connID)
+		r.disconnect(context.WithoutCancel(req.Context()), reg, state, pkgws.CloseInfo{Code: pkgws.CloseAbnormalClosure})
+	}
+
 	upgrader := r.upgrader()
 	conn, err := upgrader.Upgrade(w, req, nil)
 	if err != nil {
 		log.Printf("[WS] Upgrade failed (%s): %v", reg.Path, err)
+		abort()
 		return
 	}
 
-	connID := generateConnID()
-	tracked := &trackedConn{conn: conn}
+	tracked := &trackedConn{conn: conn, writeTimeout: r.options.WriteTimeout}
 	if !r.trackConn(connID, tracked) {
 		_ = conn.Close()
+		abort()
 		return
 	}
 	connCtx, cancelConn := context.WithCancel(req.Context())
 	stopRuntimeCancellation := context.AfterFunc(r.ctx, cancelConn)
+	closeInfo := pkgws.CloseInfo{Code: pkgws.CloseAbnormalClosure}
 	defer func() {
 		stopRuntimeCancellation()
 		cancelConn()
 		r.untrackConn(connID)
 		_ = conn.Close()
+
+		if r.ctx.Err() != nil {
+			closeInfo = pkgws.CloseInfo{Code: pkgws.CloseNormalClosure, Reason: "server shutting down"}
+		}
+		r.disconnect(context.WithoutCancel(req.Context()), reg, state, closeInfo)
 	}()
 
 	log.Printf("[WS] Connection established (conn=%p, path=%s)", &connID, reg.Path)
@@ -179,9 +236,7 @@ func (r *Runtime) HandleConn(w http.ResponseWriter, req *http.Request, reg Regis
 		return conn.SetReadDeadline(time.Now().Add(r.options.ReadTimeout))
 	})
 
-	sendFn := func(messageType int, data []byte) error {
-		return tracked.writeMessage(messageType, data, r.options.WriteTimeout)
-	}
+	sendFn := tracked.send
 
 	done := make(chan struct{})
 	defer close(done)
@@ -210,41 +265,98 @@ func (r *Runtime) HandleConn(w http.ResponseWriter, req *http.Request, reg Regis
 		msgType, payload, err := conn.ReadMessage()
 		if err != nil {
 			log.Printf("[WS] Connection closed (conn=%p): %v", &connID, err)
+			closeInfo = closeInfoFromError(err)
 			return
 		}
 
-		ctx := NewWSExecutionContext(
-			connCtx,
-			connID,
-			req.URL.Path,
-			msgType,
-			payload,
-			nil,
-			sendFn,
-		)
+		ctx, frame, err := r.messageContext(connCtx, state, reg, msgType, payload, sendFn)
+		if err != nil {
+			// 형식이 잘못되었거나 등록되지 않은 타입의 메시지는 에러 프레임만 보내고 연결은 유지한다.
+			log.Printf("[WS] Invalid message (conn=%p): %v", &connID, err)
+			if sendErr := sendErrorFrame(sendFn, reg, frame, map[string]any{"message": err.Error()}); sendErr != nil {
+				return
+			}
+			_ = conn.SetReadDeadline(time.Now().Add(r.options.ReadTimeout))
+			continue
+		}
 
 		if err := r.pipeline.Execute(ctx); err != nil {
+			// ws.CloseError로 지정한 에러만 연결을 닫는다.
+			var closeErr *pkgws.CloseError
+			if errors.As(err, &closeErr) {
+				log.Printf("[WS] Closing connection (conn=%p): %v", &connID, err)
+				closeInfo = pkgws.CloseInfo{Code: closeErr.Code, Reason: truncateCloseReason(closeErr.Reason)}
+				_ = tracked.writeControl(
+					websocket.CloseMessage,
+					websocket.FormatCloseMessage(closeInfo.Code, closeInfo.Reason),
+					r.options.WriteTimeout,
+				)
+				return
+			}
+
+			// 그 외 에러는 에러 프레임만 보내고 연결은 유지한다.
 			log.Printf("[WS] Handler failed (conn=%p): %v", &connID, err)
-			_ = tracked.writeControl(
-				websocket.CloseMessage,
-				websocket.FormatCloseMessage(websocket.CloseInternalServerErr, "handler error"),
-				r.options.WriteTimeout,
-			)
+			if sendErr := sendErrorFrame(sendFn, reg, frame, errorBody(err)); sendErr != nil {
+				return
+			}
+			_ = conn.SetReadDeadline(time.Now().Add(r.options.ReadTimeout))
+			continue
+		}
+
+		if err := acknowledge(ctx, sendFn, reg, frame); err != nil {
 			return
 		}
 		_ = conn.SetReadDeadline(time.Now().Add(r.options.ReadTimeout))
 	}
 }
 
+// errorBody는 실행 에러를 클라이언트에 보낼 에러 프레임 본문으로 바꿉니다.
+// 검증 실패와 *httperr.HTTPError는 내용을 담고, 그 외 에러는 내부 정보를 숨긴다.
+func errorBody(err error) map[string]any {
+	var fieldErrs validate.Errors
+	if errors.As(err, &fieldErrs) {
+		return map[string]any{
+			"message": "Validation failed",
+			"details": fieldErrs,
+		}
+	}
+
+	var httpErr *httperr.HTTPError
+	if errors.As(err, &httpErr) {
+		body := map[string]any{
+			"status":  httpErr.Status,
+			"message": httpErr.Message,
+		}
+		if httpErr.Details != nil {
+			body["details"] = httpErr.Details
+		}
+		return body
+	}
+
+	return map[string]any{"message": "Internal server error"}
+}
+
+// 종료 프레임의 payload는 125바이트까지이고, 그중 2바이트는 종료 코드다.
+const maxCloseReasonBytes = 123
+
+func truncateCloseReason(reason string) string {
+	if len(reason) <= maxCloseReasonBytes {
+		return reason
+	}
+	// UTF-8 문자가 잘리지 않도록 문자 경계에서 자른다.
+	cut := maxCloseReasonBytes
+	for cut > 0 && !utf8.RuneStart(reason[cut]) {
+		cut--
+	}
+	return reason[:cut]
+}
+
 func (r *Runtime) Stop() {
 	r.stopOnce.Do(func() {
 		r.cancel()
 
 		r.connMu.Lock()
-		conns := make(map[string]*trackedConn, len(r.conns))
-		for id, conn := range r.conns {
-			conns[id] = conn
-		}
+		conns := r.hub.snapshot()
 		r.connMu.Unlock()
 
 		for _, conn := range conns {
@@ -268,15 +380,14 @@ func (r *Runtime) trackConn(connID string, conn *trackedConn) bool {
 	case <-r.ctx.Done():
 		return false
 	default:
-		r.conns[connID] = conn
+		r.hub.add(connID, conn)
 		return true
 	}
 }
 
+// untrackConn은 연결을 Hub에서 지웁니다. 들어가 있던 방에서도 모두 빠집니다.
 func (r *Runtime) untrackConn(connID string) {
-	r.connMu.Lock()
-	defer r.connMu.Unlock()
-	delete(r.conns, connID)
+	r.hub.remove(connID)
 }
 
 func isAllowedWebSocketOrigin(req *http.Request, allowedOrigins []string) bool {
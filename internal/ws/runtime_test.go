@@ -2,20 +2,25 @@ package ws
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
+	"unicode/utf8"
 
+	"github.com/NARUBROWN/spine/core"
 	"github.com/NARUBROWN/spine/internal/container"
-	"github.com/NARUBROWN/spine/internal/invoker"
-	"github.com/NARUBROWN/spine/internal/pipeline"
-	"github.com/NARUBROWN/spine/internal/resolver"
-	spinerouter "github.com/NARUBROWN/spine/internal/router"
 	"github.com/NARUBROWN/spine/pkg/boot"
+	"github.com/NARUBROWN/spine/pkg/header"
+	"github.com/NARUBROWN/spine/pkg/httperr"
+	"github.com/NARUBROWN/spine/pkg/path"
+	"github.com/NARUBROWN/spine/pkg/query"
+	"github.com/NARUBROWN/spine/pkg/spine"
 	pkgws "github.com/NARUBROWN/spine/pkg/ws"
 	"github.com/gorilla/websocket"
 )
@@ -61,6 +66,554 @@ func (c *concurrentSendController) Burst(ctx context.Context) {
 	close(c.errs)
 }
 
+type validatedChatMessage struct {
+	Text string `json:"text" validate:"required,max=5"`
+}
+
+type validationController struct{}
+
+func (c *validationController) Chat(ctx context.Context, msg validatedChatMessage) error {
+	return pkgws.Send(ctx, pkgws.TextMessage, []byte("ok:"+msg.Text))
+}
+
+// hubController는 받은 payload 이름의 방에 연결을 넣습니다.
+type hubController struct {
+	hub pkgws.Hub
+}
+
+func (c *hubController) Join(ctx context.Context, id pkgws.ConnectionID, room []byte) error {
+	if err := c.hub.Join(id.Value, string(room)); err != nil {
+		return err
+	}
+	return pkgws.Send(ctx, pkgws.TextMessage, []byte("joined"))
+}
+
+func TestRuntime_HubBroadcastsToRoomAndCleansUpOnDisconnect(t *testing.T) {
+	hub := NewHub()
+	runtime, registration := newTestRuntime(t, &hubController{hub: hub}, (*hubController).Join, boot.WebSocketOptions{
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+		PingInterval: time.Second,
+	})
+	runtime.SetHub(hub)
+	defer runtime.Stop()
+
+	server := newRuntimeTestServer(runtime, registration)
+	defer server.Close()
+
+	readText := func(conn *websocket.Conn) string {
+		t.Helper()
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+		_, payload, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("메시지 수신 실패: %v", err)
+		}
+		return string(payload)
+	}
+
+	lobby := []*websocket.Conn{dialRuntimeTestServer(t, server), dialRuntimeTestServer(t, server)}
+	other := dialRuntimeTestServer(t, server)
+	defer other.Close()
+	for i, conn := range append(lobby, other) {
+		room := "lobby"
+		if i == 2 {
+			room = "other"
+		}
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(room)); err != nil {
+			t.Fatalf("메시지 전송 실패: %v", err)
+		}
+		if got := readText(conn); got != "joined" {
+			t.Fatalf("방 입장 응답이 잘못되었습니다: %s", got)
+		}
+	}
+
+	if err := hub.Broadcast("lobby", pkgws.Text("hello")); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	for _, conn := range lobby {
+		if got := readText(conn); got != "hello" {
+			t.Fatalf("방의 모든 연결이 메시지를 받아야 합니다: %s", got)
+		}
+	}
+
+	otherID := hub.Members("other")[0]
+	if err := hub.SendTo(otherID, pkgws.Text("direct")); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if got := readText(other); got != "direct" {
+		t.Fatalf("SendTo는 지정한 연결에만 보내야 합니다: %s", got)
+	}
+
+	// 연결이 끊어지면 방에서 자동으로 빠져야 한다.
+	_ = lobby[0].Close()
+	deadline := time.Now().Add(time.Second)
+	for len(hub.Members("lobby")) != 1 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if members := hub.Members("lobby"); len(members) != 1 {
+		t.Fatalf("끊어진 연결은 방에서 빠져야 합니다: %v", members)
+	}
+	if len(hub.Connections()) != 2 {
+		t.Fatalf("끊어진 연결은 연결 목록에서 빠져야 합니다: %v", hub.Connections())
+	}
+	_ = lobby[1].Close()
+}
+
+// connectJoinController는 OnConnect에서 연결을 방에 넣습니다.
+type connectJoinController struct {
+	hub pkgws.Hub
+}
+
+func (c *connectJoinController) OnConnect(id pkgws.ConnectionID) error {
+	return c.hub.Join(id.Value, "lobby")
+}
+
+func (c *connectJoinController) Echo(ctx context.Context, payload []byte) error {
+	return pkgws.Send(ctx, pkgws.TextMessage, payload)
+}
+
+func TestRuntime_HubJoinFromOnConnect(t *testing.T) {
+	hub := NewHub()
+	runtime, registration := newTestRuntime(t, &connectJoinController{hub: hub}, (*connectJoinController).Echo, boot.WebSocketOptions{
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+		PingInterval: time.Second,
+	}, pkgws.OnConnect((*connectJoinController).OnConnect))
+	runtime.SetHub(hub)
+	defer runtime.Stop()
+
+	server := newRuntimeTestServer(runtime, registration)
+	defer server.Close()
+
+	conn := dialRuntimeTestServer(t, server)
+	defer conn.Close()
+
+	// 업그레이드 응답 뒤에 연결이 등록되므로 등록될 때까지 기다린다.
+	deadline := time.Now().Add(time.Second)
+	for len(hub.Connections()) != 1 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if members := hub.Members("lobby"); len(members) != 1 {
+		t.Fatalf("OnConnect에서 Join한 연결은 방에 들어 있어야 합니다: %v", members)
+	}
+
+	if err := hub.Broadcast("lobby", pkgws.Text("welcome")); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, payload, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("메시지 수신 실패: %v", err)
+	}
+	if string(payload) != "welcome" {
+		t.Fatalf("OnConnect에서 Join한 방의 Broadcast를 받아야 합니다: %s", payload)
+	}
+}
+
+// lifecycleController는 token 쿼리로 연결을 허용하고, 연결 속성을 메시지 핸들러에서 읽습니다.
+type lifecycleController struct {
+	disconnected chan pkgws.CloseInfo
+}
+
+func (c *lifecycleController) OnConnect(h pkgws.Handshake, attrs pkgws.Attributes) error {
+	if h.Query.Get("token") != "secret" {
+		return httperr.Unauthorized("invalid token")
+	}
+	attrs.Set("user", "kim")
+	return nil
+}
+
+func (c *lifecycleController) Whoami(ctx context.Context, spineCtx spine.Ctx) error {
+	user, _ := spineCtx.Get("user")
+	role, _ := spineCtx.Get("role")
+	return pkgws.Send(ctx, pkgws.TextMessage, []byte(fmt.Sprintf("%v/%v", user, role)))
+}
+
+func (c *lifecycleController) OnDisconnect(info pkgws.CloseInfo) {
+	c.disconnected <- info
+}
+
+// connectRoleInterceptor는 연결 시점에만 역할을 Set합니다.
+type connectRoleInterceptor struct {
+	calls atomic.Int32
+}
+
+func (i *connectRoleInterceptor) PreHandle(ctx core.ExecutionContext, meta core.HandlerMeta) error {
+	i.calls.Add(1)
+	if ctx.Method() == MethodConnect {
+		ctx.Set("role", "admin")
+	}
+	return nil
+}
+
+func (i *connectRoleInterceptor) PostHandle(ctx core.ExecutionContext, meta core.HandlerMeta) {}
+
+func (i *connectRoleInterceptor) AfterCompletion(ctx core.ExecutionContext, meta core.HandlerMeta, err error) {
+}
+
+func newLifecycleTestServer(t *testing.T, interceptor core.Interceptor) (*lifecycleController, *Runtime, *httptest.Server) {
+	t.Helper()
+	controller := &lifecycleController{disconnected: make(chan pkgws.CloseInfo, 1)}
+	runtime, registration := newTestRuntime(t, controller, (*lifecycleController).Whoami, boot.WebSocketOptions{
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+		PingInterval: time.Second,
+	},
+		pkgws.OnConnect((*lifecycleController).OnConnect),
+		pkgws.OnDisconnect((*lifecycleController).OnDisconnect),
+		pkgws.WithInterceptors(interceptor),
+	)
+	return controller, runtime, newRuntimeTestServer(runtime, registration)
+}
+
+func TestRuntime_OnConnectRejectsUpgradeWithHTTPStatus(t *testing.T) {
+	controller, runtime, server := newLifecycleTestServer(t, &connectRoleInterceptor{})
+	defer runtime.Stop()
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "?token=wrong"
+	_, resp, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err == nil {
+		t.Fatal("잘못된 토큰이면 업그레이드가 거절되어야 합니다")
+	}
+	if resp == nil || resp.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("OnConnect의 HTTPError 상태 코드로 응답해야 합니다: %v", resp)
+	}
+	if len(runtime.Hub().Connections()) != 0 {
+		t.Fatal("거절된 연결은 Hub에 등록되면 안 됩니다")
+	}
+
+	select {
+	case info := <-controller.disconnected:
+		t.Fatalf("열리지 않은 연결에 OnDisconnect가 실행되면 안 됩니다: %+v", info)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestRuntime_RejectsDisallowedOriginBeforeOnConnect(t *testing.T) {
+	interceptor := &connectRoleInterceptor{}
+	_, runtime, server := newLifecycleTestServer(t, interceptor)
+	defer runtime.Stop()
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "?token=secret"
+	_, resp, err := websocket.DefaultDialer.Dial(wsURL, http.Header{"Origin": {"https://evil.example"}})
+	if err == nil {
+		t.Fatal("허용되지 않은 Origin이면 업그레이드가 거절되어야 합니다")
+	}
+	if resp == nil || resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("403으로 응답해야 합니다: %v", resp)
+	}
+
+	plain, err := http.Get(server.URL + "?token=secret")
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	_ = plain.Body.Close()
+	if plain.StatusCode != http.StatusBadRequest {
+		t.Fatalf("WebSocket 업그레이드 요청이 아니면 400으로 응답해야 합니다. 실제=%d", plain.StatusCode)
+	}
+
+	if calls := interceptor.calls.Load(); calls != 0 {
+		t.Fatalf("업그레이드할 수 없는 요청으로 OnConnect가 실행되면 안 됩니다. 실행 횟수=%d", calls)
+	}
+}
+
+func TestRuntime_OnConnectAttributesReachMessageHandlersAndOnDisconnectRuns(t *testing.T) {
+	interceptor := &connectRoleInterceptor{}
+	controller, runtime, server := newLifecycleTestServer(t, interceptor)
+	defer runtime.Stop()
+	defer server.Close()
+
+	conn := dialRuntimeTestServerWithQuery(t, server, "?token=secret")
+	if err := conn.WriteMessage(websocket.TextMessage, []byte("whoami")); err != nil {
+		t.Fatalf("메시지 전송 실패: %v", err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, payload, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("메시지 수신 실패: %v", err)
+	}
+	if string(payload) != "kim/admin" {
+		t.Fatalf("OnConnect와 Interceptor가 Set한 값을 메시지 핸들러에서 읽어야 합니다: %s", payload)
+	}
+
+	_ = conn.WriteControl(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseGoingAway, "bye"),
+		time.Now().Add(time.Second),
+	)
+	_ = conn.Close()
+
+	select {
+	case info := <-controller.disconnected:
+		if info.Code != pkgws.CloseGoingAway || info.Reason != "bye" {
+			t.Fatalf("OnDisconnect는 클라이언트의 종료 코드와 사유를 받아야 합니다: %+v", info)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("OnDisconnect가 실행되지 않았습니다")
+	}
+
+	// OnConnect, 메시지, OnDisconnect 모두 같은 Interceptor를 거친다.
+	if calls := interceptor.calls.Load(); calls != 3 {
+		t.Fatalf("Interceptor 실행 횟수가 잘못되었습니다: %d", calls)
+	}
+}
+
+// handshakeController는 핸드셰이크의 경로 파라미터, 쿼리, 헤더를 그대로 돌려줍니다.
+type handshakeController struct{}
+
+func (c *handshakeController) Echo(ctx context.Context, roomID path.String, q query.Values, h header.Values) error {
+	reply := fmt.Sprintf("%s/%s/%s", roomID.Value, q.Get("nick"), h.Get("X-Client"))
+	return pkgws.Send(ctx, pkgws.TextMessage, []byte(reply))
+}
+
+func TestRuntime_MessageHandlersReadHandshakePathQueryAndHeaders(t *testing.T) {
+	runtime, registration := newTestRuntimeAt(t, "/ws/rooms/:roomId", &handshakeController{}, (*handshakeController).Echo, boot.WebSocketOptions{
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+		PingInterval: time.Second,
+	})
+	defer runtime.Stop()
+
+	server := newRuntimeTestServer(runtime, registration)
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + "/ws/rooms/42?nick=kim"
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, http.Header{"X-Client": []string{"mobile"}})
+	if err != nil {
+		t.Fatalf("WebSocket 연결 실패: %v", err)
+	}
+	defer conn.Close()
+
+	// 같은 연결의 모든 메시지에서 핸드셰이크 값을 읽을 수 있어야 한다.
+	for range 2 {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte("ping")); err != nil {
+			t.Fatalf("메시지 전송 실패: %v", err)
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+		_, payload, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("메시지 수신 실패: %v", err)
+		}
+		if string(payload) != "42/kim/mobile" {
+			t.Fatalf("핸드셰이크 값이 잘못되었습니다: %s", payload)
+		}
+	}
+}
+
+type joinRequest struct {
+	Room string `json:"room" validate:"required"`
+}
+
+type joinReply struct {
+	Room   string `json:"room"`
+	Joined bool   `json:"joined"`
+}
+
+// envelopeController는 메시지 타입별로 나뉜 핸들러입니다.
+type envelopeController struct{}
+
+func (c *envelopeController) Join(req joinRequest) (joinReply, error) {
+	return joinReply{Room: req.Room, Joined: true}, nil
+}
+
+func (c *envelopeController) Ping() error {
+	return nil
+}
+
+func TestRuntime_DispatchesEnvelopeByMessageType(t *testing.T) {
+	runtime, registration := newTestRuntime(t, &envelopeController{}, pkgws.On("join", (*envelopeController).Join), boot.WebSocketOptions{
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+		PingInterval: time.Second,
+	}, pkgws.On("ping", (*envelopeController).Ping))
+	defer runtime.Stop()
+
+	server := newRuntimeTestServer(runtime, registration)
+	defer server.Close()
+
+	conn := dialRuntimeTestServer(t, server)
+	defer conn.Close()
+
+	cases := []struct {
+		name    string
+		request string
+		reply   string
+	}{
+		{"반환값은 요청 ID를 담은 응답으로 보낸다", `{"type":"join","id":"1","data":{"room":"lobby"}}`, `{"data":{"room":"lobby","joined":true},"id":"1","type":"join"}`},
+		{"반환값이 없으면 ack를 보낸다", `{"type":"ping","id":2}`, `{"id":"2","type":"ping"}`},
+		{"등록되지 않은 타입은 에러 응답을 보낸다", `{"type":"nope","id":"3"}`, `{"error":{"message":"Unknown message type: nope"},"id":"3","type":"nope"}`},
+		{"검증 실패는 에러 응답을 보낸다", `{"type":"join","id":"4","data":{}}`, `{"error":{"details":[{"field":"room","rule":"required","message":"is required"}],"message":"Validation failed"},"id":"4","type":"join"}`},
+		{"Envelope가 아니면 에러 프레임을 보낸다", `hello`, `{"message":"Invalid message envelope"}`},
+	}
+
+	for _, tc := range cases {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(tc.request)); err != nil {
+			t.Fatalf("%s: 메시지 전송 실패: %v", tc.name, err)
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+		_, payload, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("%s: 메시지 수신 실패: %v", tc.name, err)
+		}
+		if string(payload) != tc.reply {
+			t.Fatalf("%s: 응답이 잘못되었습니다: %s", tc.name, payload)
+		}
+	}
+}
+
+func TestRuntime_DispatchesWithCustomEnvelope(t *testing.T) {
+	envelope := pkgws.JSONEnvelope{TypeField: "event", IDField: "ref", DataField: "payload"}
+	runtime, registration := newTestRuntime(t, &envelopeController{}, pkgws.On("join", (*envelopeController).Join), boot.WebSocketOptions{
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+		PingInterval: time.Second,
+	}, pkgws.WithEnvelope(envelope))
+	defer runtime.Stop()
+
+	server := newRuntimeTestServer(runtime, registration)
+	defer server.Close()
+
+	conn := dialRuntimeTestServer(t, server)
+	defer conn.Close()
+
+	if err := conn.WriteMessage(websocket.TextMessage, []byte(`{"event":"join","ref":"a","payload":{"room":"lobby"}}`)); err != nil {
+		t.Fatalf("메시지 전송 실패: %v", err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, payload, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("메시지 수신 실패: %v", err)
+	}
+	if string(payload) != `{"event":"join","payload":{"room":"lobby","joined":true},"ref":"a"}` {
+		t.Fatalf("지정한 Envelope 형식으로 응답해야 합니다: %s", payload)
+	}
+}
+
+// frameController는 받은 payload에 따라 여러 종류의 값이나 에러를 반환합니다.
+type frameController struct{}
+
+func (c *frameController) Handle(payload []byte) (any, error) {
+	switch string(payload) {
+	case "struct":
+		return joinReply{Room: "lobby", Joined: true}, nil
+	case "bytes":
+		return []byte{0x01, 0x02}, nil
+	case "text":
+		return "hi", nil
+	case "notfound":
+		return nil, httperr.NotFound("room not found")
+	case "boom":
+		return nil, fmt.Errorf("db down")
+	case "ban":
+		return nil, pkgws.NewCloseError(pkgws.ClosePolicyViolation, "banned")
+	}
+	return nil, nil
+}
+
+func TestRuntime_SendsReturnValuesAndErrorFramesUntilFatalError(t *testing.T) {
+	runtime, registration := newTestRuntime(t, &frameController{}, (*frameController).Handle, boot.WebSocketOptions{
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+		PingInterval: time.Second,
+	})
+	defer runtime.Stop()
+
+	server := newRuntimeTestServer(runtime, registration)
+	defer server.Close()
+
+	conn := dialRuntimeTestServer(t, server)
+	defer conn.Close()
+
+	cases := []struct {
+		name        string
+		request     string
+		messageType int
+		reply       string
+	}{
+		{"struct는 JSON 텍스트 프레임으로 보낸다", "struct", websocket.TextMessage, `{"room":"lobby","joined":true}`},
+		{"[]byte는 바이너리 프레임으로 보낸다", "bytes", websocket.BinaryMessage, "\x01\x02"},
+		{"string은 텍스트 프레임으로 보낸다", "text", websocket.TextMessage, "hi"},
+		{"HTTPError는 에러 프레임을 보내고 연결을 유지한다", "notfound", websocket.TextMessage, `{"message":"room not found","status":404}`},
+		{"그 외 에러는 내부 정보를 숨긴 에러 프레임을 보낸다", "boom", websocket.TextMessage, `{"message":"Internal server error"}`},
+	}
+
+	for _, tc := range cases {
+		if err := conn.WriteMessage(websocket.TextMessage, []byte(tc.request)); err != nil {
+			t.Fatalf("%s: 메시지 전송 실패: %v", tc.name, err)
+		}
+		_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+		messageType, payload, err := conn.ReadMessage()
+		if err != nil {
+			t.Fatalf("%s: 메시지 수신 실패: %v", tc.name, err)
+		}
+		if messageType != tc.messageType || string(payload) != tc.reply {
+			t.Fatalf("%s: 프레임이 잘못되었습니다: type=%d payload=%q", tc.name, messageType, payload)
+		}
+	}
+
+	// ws.CloseError만 지정한 코드로 연결을 닫는다.
+	if err := conn.WriteMessage(websocket.TextMessage, []byte("ban")); err != nil {
+		t.Fatalf("메시지 전송 실패: %v", err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, _, err := conn.ReadMessage()
+	if !websocket.IsCloseError(err, websocket.ClosePolicyViolation) {
+		t.Fatalf("CloseError의 종료 코드로 연결이 닫혀야 합니다: %v", err)
+	}
+	if closeErr := err.(*websocket.CloseError); closeErr.Text != "banned" {
+		t.Fatalf("종료 사유가 잘못되었습니다: %s", closeErr.Text)
+	}
+}
+
+func TestTruncateCloseReason_KeepsUTF8Boundary(t *testing.T) {
+	reason := truncateCloseReason(strings.Repeat("가", 50))
+	if len(reason) > maxCloseReasonBytes || !utf8.ValidString(reason) {
+		t.Fatalf("종료 사유는 문자 경계에서 123바이트 이하로 잘려야 합니다: %d", len(reason))
+	}
+}
+
+func TestRuntime_ValidationFailureSendsErrorFrameAndKeepsConnection(t *testing.T) {
+	runtime, registration := newTestRuntime(t, &validationController{}, (*validationController).Chat, boot.WebSocketOptions{
+		ReadTimeout:  time.Second,
+		WriteTimeout: time.Second,
+		PingInterval: time.Second,
+	})
+	defer runtime.Stop()
+
+	server := newRuntimeTestServer(runtime, registration)
+	defer server.Close()
+	conn := dialRuntimeTestServer(t, server)
+	defer conn.Close()
+
+	if err := conn.WriteMessage(websocket.TextMessage, []byte(`{"text":"too long text"}`)); err != nil {
+		t.Fatalf("메시지 전송 실패: %v", err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, payload, err := conn.ReadMessage()
+	if err != nil {
+		t.Fatalf("에러 프레임 수신 실패: %v", err)
+	}
+	var frame struct {
+		Message string           `json:"message"`
+		Details []map[string]any `json:"details"`
+	}
+	if err := json.Unmarshal(payload, &frame); err != nil || len(frame.Details) != 1 || frame.Details[0]["field"] != "text" {
+		t.Fatalf("검증 에러 프레임이 잘못되었습니다: %s (%v)", payload, err)
+	}
+
+	// 연결은 유지되어 다음 메시지를 처리해야 함
+	if err := conn.WriteMessage(websocket.TextMessage, []byte(`{"text":"hi"}`)); err != nil {
+		t.Fatalf("메시지 전송 실패: %v", err)
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(time.Second))
+	_, payload, err = conn.ReadMessage()
+	if err != nil || string(payload) != "ok:hi" {
+		t.Fatalf("검증 실패 후에도 연결이 유지되어야 합니다: %s (%v)", payload, err)
+	}
+}
+
 func TestRuntime_StopCancelsActiveHandlerAndRejectsNewConnections(t *testing.T) {
 	controller := &cancellationController{
 		started:  make(chan struct{}),
@@ -145,31 +698,36 @@ func TestRuntime_ConcurrentSendsRemainSafeWhilePingRuns(t *testing.T) {
 	}
 }
 
-func newTestRuntime(t *testing.T, controller any, handler any, options boot.WebSocketOptions) (*Runtime, Registration) {
+func newTestRuntime[T any](t *testing.T, controller T, handler any, options boot.WebSocketOptions, opts ...pkgws.Option) (*Runtime, Registration) {
+	t.Helper()
+	return newTestRuntimeAt(t, "/", controller, handler, options, opts...)
+}
+
+// newTestRuntimeAt은 controller를 등록한 컨테이너와 bootstrap과 같은 파이프라인으로 Runtime을 만듭니다.
+func newTestRuntimeAt[T any](t *testing.T, registeredPath string, controller T, handler any, options boot.WebSocketOptions, opts ...pkgws.Option) (*Runtime, Registration) {
 	t.Helper()
 
 	registry := NewRegistry()
-	if err := registry.Register("/", handler); err != nil {
+	if err := registry.Register(registeredPath, handler, opts...); err != nil {
 		t.Fatalf("WebSocket 등록 실패: %v", err)
 	}
-	registration := registry.Registrations()[0]
 
 	c := container.New()
-	switch typed := controller.(type) {
-	case *cancellationController:
-		_ = c.RegisterConstructor(func() *cancellationController { return typed })
-	case *concurrentSendController:
-		_ = c.RegisterConstructor(func() *concurrentSendController { return typed })
-	default:
-		t.Fatalf("지원하지 않는 테스트 컨트롤러: %T", controller)
-	}
+	register(t, c, controller)
 
-	router := spinerouter.NewRouter()
-	router.Register("WS", registration.Path, registration.Meta)
-	p := pipeline.NewPipeline(router, invoker.NewInvoker(c))
-	p.AddArgumentResolver(&resolver.StdContextResolver{})
+	p, err := NewPipeline(c, registry, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("파이프라인 생성 실패: %v", err)
+	}
+	return NewRuntime(registry, p, options), registry.Registrations()[0]
+}
 
-	return NewRuntime(registry, p, options), registration
+// register는 테스트 컨트롤러 인스턴스를 그대로 반환하는 생성자를 등록합니다.
+func register[T any](t *testing.T, c *container.Container, v T) {
+	t.Helper()
+	if err := c.RegisterConstructor(func() T { return v }); err != nil {
+		t.Fatalf("생성자 등록 실패: %v", err)
+	}
 }
 
 func newRuntimeTestServer(runtime *Runtime, registration Registration) *httptest.Server {
@@ -180,7 +738,12 @@ func newRuntimeTestServer(runtime *Runtime, registration Registration) *httptest
 
 func dialRuntimeTestServer(t *testing.T, server *httptest.Server) *websocket.Conn {
 	t.Helper()
-	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+	return dialRuntimeTestServerWithQuery(t, server, "")
+}
+
+func dialRuntimeTestServerWithQuery(t *testing.T, server *httptest.Server, query string) *websocket.Conn {
+	t.Helper()
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http") + query
 	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
 	if err != nil {
 		t.Fatalf("WebSocket 연결 실패: %v", err)
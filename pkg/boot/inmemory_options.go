@@ -0,0 +1,23 @@
+package boot
+
+import "time"
+
+/*
+InMemoryOptions는 프로세스 내부 이벤트 브로커 설정입니다.
+Kafka나 RabbitMQ 없이, HTTP 핸들러에서 발행한 도메인 이벤트를 같은 바이너리의
+Consumers() 등록으로 전달합니다. 로컬 개발과 테스트 용도이며 메시지는 프로세스가 종료되면 사라집니다.
+*/
+type InMemoryOptions struct {
+	// Consumer Group 이름입니다.
+	// 그룹마다 모든 메시지를 한 번씩 받고, 같은 그룹의 Reader끼리는 메시지를 나눠 받습니다.
+	// 빈 값이면 "spine"을 사용합니다.
+	GroupID string
+
+	// 발행된 메시지가 Consumer에 전달되기까지의 지연입니다.
+	// 0이면 즉시 전달합니다.
+	DeliveryDelay time.Duration
+
+	// NACK된 메시지를 다시 전달하기까지의 지연입니다.
+	// 0이면 즉시 다시 전달합니다.
+	RedeliveryDelay time.Duration
+}
@@ -28,6 +28,34 @@ Topic 이름 규칙과 관련된 정책을 정의합니다.
 type KafkaWriteOptions struct {
 	// 이벤트 이름 앞에 붙일 Topic Prefix
 	TopicPrefix string
+
+	/*
+		지연 발행(publish.DelayedEvent, publish.Scheduled) 메시지를 보관할 Topic입니다.
+		지정하면 Spine이 이 Topic을 읽어 전달 시각에 원래 Topic으로 옮기는 relay를 실행합니다.
+		비어 있으면 Kafka로 지연 발행할 수 없습니다.
+	*/
+	DelayTopic string
+
+	// 지연 발행 relay의 Consumer Group ID입니다. 비어 있으면 "spine-delay"를 사용합니다.
+	DelayGroupID string
+
+	/*
+		지연 발행 relay가 동시에 대기시키는 메시지의 최대 개수입니다. 0 이하이면 1000입니다.
+		대기 중인 메시지가 가득 차면 하나가 옮겨질 때까지 지연 Topic을 더 읽지 않습니다.
+	*/
+	DelayMaxInFlight int
+
+	/*
+		요청/응답(publish.Requester)의 응답을 받을 Topic입니다.
+		지정하면 Kafka로 요청을 보낼 수 있으며, 요청을 처리한 Consumer는 응답을 이 Topic에 기록합니다.
+	*/
+	ReplyTopic string
+
+	/*
+		응답 Topic을 읽는 Consumer Group ID입니다.
+		인스턴스마다 모든 응답을 읽어야 하므로 인스턴스마다 달라야 하며, 비어 있으면 실행마다 새로 만듭니다.
+	*/
+	ReplyGroupID string
 }
 
 /*
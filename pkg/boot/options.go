@@ -2,11 +2,13 @@ package boot
 
 import (
 	"time"
+
+	"github.com/NARUBROWN/spine/pkg/event/codec"
 )
 
 /*
 애플리케이션 부트스트랩 전반을 제어하는 최상위 옵션입니다.
-서버 실행 방식과 외부 인프라(Kafka, RabbitMQ) 활성화를 결정합니다.
+서버 실행 방식과 이벤트 인프라(Kafka, RabbitMQ, In-Memory) 활성화를 결정합니다.
 */
 type Options struct {
 	// 서버가 바인딩될 주소 (예: ":8080")
@@ -30,11 +32,30 @@ type Options struct {
 	*/
 	RabbitMQ *RabbitMqOptions
 
+	/*
+		프로세스 내부 이벤트 브로커 설정입니다.
+		nil인 경우 In-Memory 브로커는 구성되지 않습니다.
+	*/
+	InMemory *InMemoryOptions
+
+	/*
+		이벤트 직렬화 형식(Codec) 설정입니다.
+		nil인 경우 JSON을 사용하고, proto.Message 이벤트는 Protobuf로 직렬화합니다.
+		Consumer는 메시지의 content-type 헤더로 알맞은 Codec을 고릅니다.
+	*/
+	Codecs *codec.Registry
+
 	/*
 		HTTP Runtime 전용 설정입니다.
 		nil인 경우 HTTP 서버는 실행되지 않습니다.
 	*/
 	HTTP *HTTPOptions
+
+	/*
+		Transactional Outbox 설정입니다.
+		nil인 경우 도메인 이벤트는 요청 처리 직후 브로커로 바로 발행됩니다.
+	*/
+	Outbox *OutboxOptions
 }
 
 /*
@@ -75,6 +96,25 @@ type HTTPOptions struct {
 
 	// WebSocket Runtime 설정입니다.
 	WebSocket WebSocketOptions
+
+	// OpenAPI 문서 제공 설정입니다.
+	// nil인 경우 문서를 제공하지 않습니다.
+	OpenAPI *OpenAPIOptions
+}
+
+/*
+OpenAPI 문서 설정입니다.
+등록된 라우트로부터 OpenAPI 3.1 문서를 생성해 지정한 경로로 제공합니다.
+*/
+type OpenAPIOptions struct {
+	// 문서를 제공할 경로입니다. (GlobalPrefix가 적용되지 않습니다)
+	// 빈 값이면 "/openapi.json"을 사용합니다.
+	Path string
+
+	// 문서 info.title / info.version / info.description 값입니다.
+	Title       string
+	Version     string
+	Description string
 }
 
 /*
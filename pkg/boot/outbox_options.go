@@ -0,0 +1,32 @@
+package boot
+
+import "time"
+
+/*
+Transactional Outbox 설정입니다.
+활성화하면 도메인 이벤트를 브로커로 바로 보내지 않고 outbox.Store에 기록한 뒤,
+백그라운드 relay가 미발행 레코드를 aggregate 순서대로 발행합니다. (최소 한 번 전달)
+outbox.Store 구현체를 생성자로 등록해야 합니다.
+
+outbox.Tx를 Scoped로 등록하면(di.Scoped(outbox.NewTx)) 요청에서 발행된 이벤트를 요청 트랜잭션 안에서 기록하고,
+실행 결과에 따라 도메인 변경과 함께 커밋 / 롤백합니다. outbox.SQLStore는 Scoped Tx 없이 기동하지 않습니다.
+
+relay는 레코드를 점유하지 않으므로, 같은 Outbox 테이블을 공유하는 인스턴스 중 하나에서만 활성화해야 합니다.
+*/
+type OutboxOptions struct {
+	// 미발행 레코드를 조회하는 주기입니다.
+	// 0이면 1초를 사용합니다.
+	PollInterval time.Duration
+
+	// 한 번에 발행할 최대 레코드 수입니다.
+	// 0이면 100을 사용합니다.
+	BatchSize int
+
+	// 발행 완료된 레코드를 보관할 기간입니다.
+	// 0이면 24시간을 사용하고, 음수면 삭제하지 않습니다.
+	Retention time.Duration
+
+	// 발행 완료된 레코드를 정리하는 주기입니다.
+	// 0이면 1분을 사용합니다.
+	CleanupInterval time.Duration
+}
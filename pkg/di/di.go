@@ -0,0 +1,107 @@
+package di
+
+import "context"
+
+// Lifetime은 컨테이너가 생성한 인스턴스의 수명을 정의합니다.
+type Lifetime int
+
+const (
+	// LifetimeSingleton은 프로세스 전체에서 하나의 인스턴스를 공유합니다. (기본값)
+	LifetimeSingleton Lifetime = iota
+	// LifetimeScoped는 하나의 ExecutionContext(요청, 메시지) 동안 하나의 인스턴스를 공유합니다.
+	LifetimeScoped
+	// LifetimeTransient는 Resolve될 때마다 새 인스턴스를 생성합니다.
+	LifetimeTransient
+)
+
+func (l Lifetime) String() string {
+	switch l {
+	case LifetimeSingleton:
+		return "singleton"
+	case LifetimeScoped:
+		return "scoped"
+	case LifetimeTransient:
+		return "transient"
+	default:
+		return "unknown"
+	}
+}
+
+/*
+Provider는 생성자와 등록 옵션을 함께 담는 선언입니다.
+App.Constructor에 생성자 함수 대신 전달할 수 있습니다.
+*/
+type Provider struct {
+	Constructor any
+	Lifetime    Lifetime
+	// Name은 한정자 이름입니다. 비어 있으면 기본 등록으로 취급합니다.
+	Name string
+	// Primary는 한정자 없이 주입될 때 여러 후보 중 우선 선택되는지 여부입니다.
+	Primary bool
+}
+
+type Option func(*Provider)
+
+// Provide는 옵션이 적용된 생성자 선언을 만듭니다. 기본 수명은 Singleton입니다.
+func Provide(constructor any, opts ...Option) Provider {
+	p := Provider{
+		Constructor: constructor,
+		Lifetime:    LifetimeSingleton,
+	}
+	for _, opt := range opts {
+		opt(&p)
+	}
+	return p
+}
+
+// Scoped는 ExecutionContext 단위로 인스턴스를 공유하는 생성자 선언을 만듭니다.
+func Scoped(constructor any, opts ...Option) Provider {
+	return Provide(constructor, append([]Option{WithLifetime(LifetimeScoped)}, opts...)...)
+}
+
+// Transient는 Resolve마다 새 인스턴스를 만드는 생성자 선언을 만듭니다.
+func Transient(constructor any, opts ...Option) Provider {
+	return Provide(constructor, append([]Option{WithLifetime(LifetimeTransient)}, opts...)...)
+}
+
+func WithLifetime(lifetime Lifetime) Option {
+	return func(p *Provider) {
+		p.Lifetime = lifetime
+	}
+}
+
+// WithName은 생성자를 이름(한정자)과 함께 등록합니다.
+// 같은 타입 또는 같은 인터페이스의 구현체를 여러 개 등록할 때 사용합니다.
+func WithName(name string) Option {
+	return func(p *Provider) {
+		p.Name = name
+	}
+}
+
+// Primary는 한정자 없는 주입에서 후보가 여러 개일 때 이 생성자를 우선 선택하게 합니다.
+func Primary() Option {
+	return func(p *Provider) {
+		p.Primary = true
+	}
+}
+
+/*
+Disposer는 Scoped / Transient 인스턴스가 스코프 종료 시 정리되어야 할 때 구현합니다.
+err는 해당 ExecutionContext의 최종 실행 에러입니다. (예: 트랜잭션 Commit / Rollback 판단)
+Disposer를 구현하지 않고 io.Closer를 구현한 경우 Close가 호출됩니다.
+*/
+type Disposer interface {
+	Dispose(err error) error
+}
+
+// Starter는 애플리케이션 시작 시 초기화 작업이 필요한 Singleton 컴포넌트가 구현합니다.
+// 부트스트랩은 의존성 순서(의존 대상 먼저)대로 OnStart를 호출하며, 에러가 발생하면 기동을 중단합니다.
+type Starter interface {
+	OnStart(ctx context.Context) error
+}
+
+// Stopper는 Graceful Shutdown 시 자원 정리가 필요한 Singleton 컴포넌트가 구현합니다.
+// 부트스트랩은 ShutdownTimeout 안에서 시작 역순으로 OnStop을 호출합니다.
+type Stopper interface {
+	OnStop(ctx context.Context) error
+}
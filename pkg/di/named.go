@@ -0,0 +1,37 @@
+package di
+
+import "reflect"
+
+// Qualifier는 Named 파라미터가 선택할 등록 이름을 제공하는 마커 타입의 계약입니다.
+//
+//	type Replica struct{}
+//	func (Replica) Qualifier() string { return "replica" }
+type Qualifier interface {
+	Qualifier() string
+}
+
+// Qualified는 컨테이너가 한정자 파라미터를 해석할 때 사용하는 계약입니다.
+// Named 외의 타입에서 직접 구현할 필요는 없습니다.
+type Qualified interface {
+	Target() (reflect.Type, string)
+}
+
+/*
+Named는 이름으로 등록된 생성자의 결과를 주입받기 위한 파라미터 타입입니다.
+
+	func NewReportService(repo di.Named[UserRepository, Replica]) *ReportService {
+		return &ReportService{repo: repo.Value}
+	}
+*/
+type Named[T any, Q Qualifier] struct {
+	Value T
+}
+
+func (Named[T, Q]) Target() (reflect.Type, string) {
+	var q Q
+	// 포인터 한정자(*Replica)는 nil 수신자로 호출되지 않도록 가리키는 값의 제로값을 사용한다.
+	if qualifierType := reflect.TypeFor[Q](); qualifierType.Kind() == reflect.Pointer {
+		q = reflect.New(qualifierType.Elem()).Interface().(Q)
+	}
+	return reflect.TypeFor[T](), q.Qualifier()
+}
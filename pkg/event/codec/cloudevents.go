@@ -0,0 +1,171 @@
+package codec
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+// CloudEvents 1.0 구조화 모드의 content type
+const CloudEventsContentType = "application/cloudevents+json"
+
+// CloudEvents 바이너리 모드에서 속성을 담는 헤더 이름의 접두사 (예: ce-id, ce-type)
+const CloudEventsHeaderPrefix = "ce-"
+
+type CloudEventsMode int
+
+const (
+	// 속성과 data를 하나의 JSON 문서로 본문에 담는다.
+	StructuredMode CloudEventsMode = iota
+	// 속성은 ce-* 헤더에, data는 그대로 본문에 담는다.
+	BinaryMode
+)
+
+/*
+CloudEvents는 이벤트를 CloudEvents 1.0 형식으로 직렬화합니다.
+
+  - id는 publish.Identified의 ID(없으면 새 ID), type은 이벤트 이름, time은 OccurredAt, subject는 publish.Keyed의 key입니다.
+  - data는 Data Codec(기본 JSON)으로 직렬화합니다.
+*/
+type CloudEvents struct {
+	Mode CloudEventsMode
+	// source 속성입니다. 빈 값이면 "spine"을 사용합니다.
+	Source string
+	// data를 직렬화할 Codec입니다. nil이면 JSON을 사용합니다.
+	Data Codec
+}
+
+// cloudEvent는 구조화 모드의 JSON 문서입니다.
+type cloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            string          `json:"time,omitempty"`
+	DataContentType string          `json:"datacontenttype,omitempty"`
+	Data            json.RawMessage `json:"data,omitempty"`
+	DataBase64      string          `json:"data_base64,omitempty"`
+}
+
+func (c CloudEvents) ContentType() string {
+	if c.Mode == BinaryMode {
+		return c.data().ContentType()
+	}
+	return CloudEventsContentType
+}
+
+func (c CloudEvents) Encode(ctx context.Context, event publish.DomainEvent) (Message, error) {
+	data, err := c.data().Encode(ctx, event)
+	if err != nil {
+		return Message{}, err
+	}
+	dataContentType := data.Header(ContentTypeHeader)
+
+	attributes := cloudEvent{
+		SpecVersion: "1.0",
+		ID:          publish.MessageID(ctx, event),
+		Source:      c.source(),
+		Type:        event.Name(),
+		Subject:     publish.MessageKey(event),
+	}
+	if occurredAt := event.OccurredAt(); !occurredAt.IsZero() {
+		attributes.Time = occurredAt.UTC().Format(time.RFC3339Nano)
+	}
+
+	if c.Mode == BinaryMode {
+		headers := map[string]string{
+			ContentTypeHeader:                       dataContentType,
+			CloudEventsHeaderPrefix + "specversion": attributes.SpecVersion,
+			CloudEventsHeaderPrefix + "id":          attributes.ID,
+			CloudEventsHeaderPrefix + "source":      attributes.Source,
+			CloudEventsHeaderPrefix + "type":        attributes.Type,
+		}
+		if attributes.Subject != "" {
+			headers[CloudEventsHeaderPrefix+"subject"] = attributes.Subject
+		}
+		if attributes.Time != "" {
+			headers[CloudEventsHeaderPrefix+"time"] = attributes.Time
+		}
+		return Message{Body: data.Body, Headers: headers}, nil
+	}
+
+	attributes.DataContentType = dataContentType
+	if isJSONContentType(dataContentType) {
+		attributes.Data = data.Body
+	} else {
+		attributes.DataBase64 = base64.StdEncoding.EncodeToString(data.Body)
+	}
+
+	body, err := json.Marshal(attributes)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{
+		Body:    body,
+		Headers: map[string]string{ContentTypeHeader: CloudEventsContentType},
+	}, nil
+}
+
+// Decode는 구조화 모드 문서에서 data를 꺼내 역직렬화합니다. 바이너리 모드는 본문이 곧 data입니다.
+func (c CloudEvents) Decode(msg Message, v any) error {
+	if mediaType(msg.Header(ContentTypeHeader)) != CloudEventsContentType {
+		return c.data().Decode(msg, v)
+	}
+
+	var envelope cloudEvent
+	if err := json.Unmarshal(msg.Body, &envelope); err != nil {
+		return fmt.Errorf("invalid CloudEvents document: %w", err)
+	}
+
+	data := Message{Headers: map[string]string{ContentTypeHeader: envelope.DataContentType}}
+	switch {
+	case envelope.DataBase64 != "":
+		decoded, err := base64.StdEncoding.DecodeString(envelope.DataBase64)
+		if err != nil {
+			return fmt.Errorf("invalid CloudEvents data_base64: %w", err)
+		}
+		data.Body = decoded
+	case len(envelope.Data) > 0:
+		data.Body = envelope.Data
+	default:
+		return errors.New("CloudEvents document has no data")
+	}
+
+	// data는 문서에 기록된 datacontenttype으로 해석한다.
+	dataCodec := c.data()
+	if envelope.DataContentType != "" && mediaType(envelope.DataContentType) != mediaType(dataCodec.ContentType()) {
+		if isJSONContentType(envelope.DataContentType) {
+			dataCodec = JSON
+		} else if known, ok := builtinDecoders[mediaType(envelope.DataContentType)]; ok {
+			dataCodec = known
+		}
+	}
+	return dataCodec.Decode(data, v)
+}
+
+func (c CloudEvents) data() Codec {
+	if c.Data == nil {
+		return JSON
+	}
+	return c.Data
+}
+
+func (c CloudEvents) source() string {
+	if c.Source == "" {
+		return "spine"
+	}
+	return c.Source
+}
+
+// isJSONContentType은 application/json 과 application/*+json 형식을 JSON으로 봅니다.
+func isJSONContentType(contentType string) bool {
+	media := mediaType(contentType)
+	return media == "" || media == "application/json" || strings.HasSuffix(media, "+json") || media == "text/json"
+}
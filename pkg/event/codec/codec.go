@@ -0,0 +1,81 @@
+package codec
+
+import (
+	"context"
+	"encoding/json"
+	"mime"
+	"strings"
+
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+// ContentTypeHeader는 메시지 본문의 형식을 나타내는 헤더 이름입니다.
+// Consumer는 이 헤더로 알맞은 Codec을 골라 본문을 역직렬화합니다.
+const ContentTypeHeader = "content-type"
+
+// Message는 직렬화된 이벤트 본문과, Codec이 붙이는 헤더입니다.
+type Message struct {
+	Body    []byte
+	Headers map[string]string
+}
+
+// Header는 이름이 정확히 일치하는 헤더를 먼저 찾고, 없으면 대소문자를 무시하고 찾습니다.
+func (m Message) Header(name string) string {
+	if value, ok := m.Headers[name]; ok {
+		return value
+	}
+	for key, value := range m.Headers {
+		if strings.EqualFold(key, name) {
+			return value
+		}
+	}
+	return ""
+}
+
+/*
+Codec은 이벤트 본문의 직렬화 형식입니다.
+Encode는 본문과 함께 content-type 등 형식 헤더를 반환하고,
+Decode는 그 헤더가 붙은 메시지를 v(포인터)로 역직렬화합니다.
+*/
+type Codec interface {
+	// ContentType은 이 Codec이 만드는 메시지의 content type입니다.
+	ContentType() string
+	Encode(ctx context.Context, event publish.DomainEvent) (Message, error)
+	Decode(msg Message, v any) error
+}
+
+// JSON은 이벤트를 JSON 본문으로 직렬화하는 기본 Codec입니다.
+var JSON Codec = jsonCodec{}
+
+type jsonCodec struct{}
+
+func (jsonCodec) ContentType() string {
+	return "application/json"
+}
+
+func (c jsonCodec) Encode(ctx context.Context, event publish.DomainEvent) (Message, error) {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{
+		Body:    body,
+		Headers: map[string]string{ContentTypeHeader: c.ContentType()},
+	}, nil
+}
+
+func (jsonCodec) Decode(msg Message, v any) error {
+	return json.Unmarshal(msg.Body, v)
+}
+
+// mediaType은 content type에서 파라미터(charset 등)를 제거하고 소문자로 반환합니다.
+func mediaType(contentType string) string {
+	if contentType == "" {
+		return ""
+	}
+	parsed, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contentType))
+	}
+	return parsed
+}
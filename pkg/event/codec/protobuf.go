@@ -0,0 +1,45 @@
+package codec
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+	"google.golang.org/protobuf/proto"
+)
+
+/*
+Protobuf는 proto.Message를 구현한 이벤트를 protobuf 바이너리로 직렬화합니다.
+Registry는 별도 지정이 없어도 proto.Message 이벤트에는 이 Codec을 사용합니다.
+*/
+var Protobuf Codec = protobufCodec{}
+
+type protobufCodec struct{}
+
+func (protobufCodec) ContentType() string {
+	return "application/protobuf"
+}
+
+func (c protobufCodec) Encode(ctx context.Context, event publish.DomainEvent) (Message, error) {
+	message, ok := event.(proto.Message)
+	if !ok {
+		return Message{}, fmt.Errorf("event %T does not implement proto.Message", event)
+	}
+
+	body, err := proto.Marshal(message)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{
+		Body:    body,
+		Headers: map[string]string{ContentTypeHeader: c.ContentType()},
+	}, nil
+}
+
+func (protobufCodec) Decode(msg Message, v any) error {
+	message, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("protobuf payload requires a proto.Message target, got %T", v)
+	}
+	return proto.Unmarshal(msg.Body, message)
+}
@@ -0,0 +1,141 @@
+package codec
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+	"google.golang.org/protobuf/proto"
+)
+
+// 별도 등록 없이 content type으로 찾을 수 있는 Codec
+var builtinDecoders = map[string]Codec{
+	"application/json":       JSON,
+	"application/protobuf":   Protobuf,
+	"application/x-protobuf": Protobuf,
+	CloudEventsContentType:   CloudEvents{},
+}
+
+/*
+Registry는 발행할 이벤트와 소비할 메시지에 사용할 Codec을 고릅니다.
+
+발행 시 우선순위
+
+ 1. ForEvent로 이벤트 타입에 지정한 Codec
+ 2. ForTopic으로 이벤트 이름(토픽)에 지정한 Codec
+ 3. proto.Message를 구현한 이벤트면 Protobuf
+ 4. 기본 Codec (NewRegistry 인자, nil이면 JSON)
+
+소비 시에는 메시지의 content-type 헤더와 일치하는 Codec을 사용하고,
+헤더가 없거나 알 수 없는 형식이면 ForTopic으로 지정한 Codec, 그다음 기본 Codec을 사용합니다.
+
+	codecs := codec.NewRegistry(codec.CloudEvents{Mode: codec.BinaryMode, Source: "/orders"}).
+		ForTopic("audit.logged", codec.JSON)
+
+nil Registry는 모든 이벤트에 JSON을 사용합니다.
+*/
+type Registry struct {
+	defaultCodec Codec
+	topics       map[string]Codec
+	types        map[reflect.Type]Codec
+	contentTypes map[string]Codec
+}
+
+func NewRegistry(defaultCodec Codec) *Registry {
+	if defaultCodec == nil {
+		defaultCodec = JSON
+	}
+
+	r := &Registry{
+		defaultCodec: defaultCodec,
+		topics:       make(map[string]Codec),
+		types:        make(map[reflect.Type]Codec),
+		contentTypes: make(map[string]Codec),
+	}
+	r.register(defaultCodec)
+	return r
+}
+
+// ForTopic은 이벤트 이름(토픽)이 topic인 메시지에 사용할 Codec을 지정합니다.
+func (r *Registry) ForTopic(topic string, codec Codec) *Registry {
+	r.topics[topic] = codec
+	r.register(codec)
+	return r
+}
+
+// ForEvent는 event와 같은 타입의 이벤트에 사용할 Codec을 지정합니다.
+func (r *Registry) ForEvent(event publish.DomainEvent, codec Codec) *Registry {
+	r.types[reflect.TypeOf(event)] = codec
+	r.register(codec)
+	return r
+}
+
+// register는 사용자가 지정한 Codec을 content type으로도 찾을 수 있게 합니다.
+func (r *Registry) register(codec Codec) {
+	if contentType := mediaType(codec.ContentType()); contentType != "" {
+		if _, exists := r.contentTypes[contentType]; !exists {
+			r.contentTypes[contentType] = codec
+		}
+	}
+}
+
+// Encoder는 event를 발행할 때 사용할 Codec을 반환합니다.
+func (r *Registry) Encoder(event publish.DomainEvent) Codec {
+	if r == nil {
+		if _, ok := event.(proto.Message); ok {
+			return Protobuf
+		}
+		return JSON
+	}
+
+	if codec, ok := r.types[reflect.TypeOf(event)]; ok {
+		return codec
+	}
+	if codec, ok := r.topics[event.Name()]; ok {
+		return codec
+	}
+	if _, ok := event.(proto.Message); ok {
+		return Protobuf
+	}
+	return r.defaultCodec
+}
+
+// Encoded는 이미 직렬화된 이벤트입니다. (예: Outbox 레코드)
+// Registry는 Codec을 고르지 않고 본문과 형식 헤더를 그대로 발행합니다.
+type Encoded interface {
+	EncodedMessage() Message
+}
+
+// Encode는 Encoder가 고른 Codec으로 event를 직렬화합니다. Encoded 이벤트는 그대로 반환합니다.
+func (r *Registry) Encode(ctx context.Context, event publish.DomainEvent) (Message, error) {
+	if encoded, ok := event.(Encoded); ok {
+		return encoded.EncodedMessage(), nil
+	}
+	return r.Encoder(event).Encode(ctx, event)
+}
+
+// Decoder는 topic에서 받은 msg를 역직렬화할 Codec을 반환합니다.
+func (r *Registry) Decoder(topic string, msg Message) Codec {
+	contentType := mediaType(msg.Header(ContentTypeHeader))
+
+	if r != nil {
+		if codec, ok := r.contentTypes[contentType]; ok && contentType != "" {
+			return codec
+		}
+	}
+	if codec, ok := builtinDecoders[contentType]; ok {
+		return codec
+	}
+	if r == nil {
+		return JSON
+	}
+	if codec, ok := r.topics[topic]; ok {
+		return codec
+	}
+	return r.defaultCodec
+}
+
+// Decode는 Decoder가 고른 Codec으로 msg를 v(포인터)에 역직렬화합니다.
+func (r *Registry) Decode(topic string, msg Message, v any) error {
+	return r.Decoder(topic, msg).Decode(msg, v)
+}
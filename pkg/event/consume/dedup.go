@@ -0,0 +1,156 @@
+package consume
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/NARUBROWN/spine/pkg/event"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+const defaultDedupTTL = 24 * time.Hour
+
+/*
+DedupStore는 처리를 마친 메시지의 키를 기록하는 저장소입니다.
+Redis, SQL 등으로 구현할 수 있으며, 개발용으로 MemoryDedupStore를 제공합니다.
+*/
+type DedupStore interface {
+	// Seen은 key가 이미 처리된 메시지인지 확인합니다.
+	Seen(ctx context.Context, key string) (bool, error)
+	// Mark는 key를 ttl 동안 처리 완료로 기록합니다.
+	Mark(ctx context.Context, key string, ttl time.Duration) error
+}
+
+/*
+DedupPolicy는 Consumer의 중복 메시지 처리 방지 정책입니다.
+이미 처리한 키의 메시지는 핸들러를 실행하지 않고 ACK 하며,
+키는 핸들러가 성공한 뒤에만 기록되므로 실패한 메시지는 다시 처리됩니다.
+
+	consumers.Register("order.created", (*OrderConsumer).OnCreated,
+		consume.WithDedup(consume.DedupPolicy{
+			Store: consume.NewMemoryDedupStore(10000),
+			TTL:   time.Hour,
+		}),
+	)
+
+키는 토픽과 핸들러별로 구분해 기록하므로, 한 토픽을 구독하는 여러 핸들러가 저장소를 공유해도 서로 영향을 주지 않습니다.
+같은 키의 메시지가 동시에 처리 중이면 둘 다 실행될 수 있으므로, 핸들러는 여전히 멱등적이어야 합니다.
+*/
+type DedupPolicy struct {
+	Store DedupStore
+
+	// 처리 완료 기록을 유지할 기간입니다. 0이면 24시간입니다.
+	TTL time.Duration
+
+	/*
+		메시지의 중복 판단 키를 반환합니다. 빈 문자열이면 중복 검사를 하지 않습니다.
+		nil이면 DefaultDedupKey(메시지 ID)를 사용합니다.
+	*/
+	Key func(metadata event.Metadata, payload []byte) string
+}
+
+// KeyOf는 메시지의 중복 판단 키를 반환합니다.
+func (p DedupPolicy) KeyOf(metadata event.Metadata, payload []byte) string {
+	if p.Key != nil {
+		return p.Key(metadata, payload)
+	}
+	return DefaultDedupKey(metadata, payload)
+}
+
+// Expiry는 처리 완료 기록을 유지할 기간을 반환합니다.
+func (p DedupPolicy) Expiry() time.Duration {
+	if p.TTL <= 0 {
+		return defaultDedupTTL
+	}
+	return p.TTL
+}
+
+// DefaultDedupKey는 브로커 메시지 ID, X-Message-Id 헤더, CloudEvents ce-id 헤더 순으로 키를 찾습니다.
+func DefaultDedupKey(metadata event.Metadata, payload []byte) string {
+	if metadata.MessageID != "" {
+		return metadata.MessageID
+	}
+	if id := metadata.Header(publish.MessageIDHeader); id != "" {
+		return id
+	}
+	return metadata.Header("ce-id")
+}
+
+/*
+MemoryDedupStore는 프로세스 메모리에 키를 보관하는 DedupStore입니다.
+capacity를 넘으면 가장 오래 사용하지 않은 키부터 지우고, TTL이 지난 키는 없는 것으로 봅니다.
+*/
+type MemoryDedupStore struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+	now      func() time.Time
+}
+
+type dedupEntry struct {
+	key       string
+	expiresAt time.Time
+}
+
+// NewMemoryDedupStore는 최대 capacity개의 키를 보관하는 저장소를 만듭니다. 0 이하이면 10000입니다.
+func NewMemoryDedupStore(capacity int) *MemoryDedupStore {
+	if capacity <= 0 {
+		capacity = 10000
+	}
+	return &MemoryDedupStore{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		now:      time.Now,
+	}
+}
+
+func (s *MemoryDedupStore) Seen(ctx context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	element, ok := s.entries[key]
+	if !ok {
+		return false, nil
+	}
+	if !s.now().Before(element.Value.(*dedupEntry).expiresAt) {
+		s.remove(element)
+		return false, nil
+	}
+	s.order.MoveToFront(element)
+	return true, nil
+}
+
+func (s *MemoryDedupStore) Mark(ctx context.Context, key string, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	expiresAt := s.now().Add(ttl)
+	if element, ok := s.entries[key]; ok {
+		element.Value.(*dedupEntry).expiresAt = expiresAt
+		s.order.MoveToFront(element)
+		return nil
+	}
+
+	s.entries[key] = s.order.PushFront(&dedupEntry{key: key, expiresAt: expiresAt})
+	for s.order.Len() > s.capacity {
+		s.remove(s.order.Back())
+	}
+	return nil
+}
+
+// Len은 보관 중인 키 개수를 반환합니다. (만료되었지만 아직 지워지지 않은 키 포함)
+func (s *MemoryDedupStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.order.Len()
+}
+
+func (s *MemoryDedupStore) remove(element *list.Element) {
+	s.order.Remove(element)
+	delete(s.entries, element.Value.(*dedupEntry).key)
+}
@@ -0,0 +1,60 @@
+package consume
+
+// Options는 Consumer 등록 단위 설정입니다.
+type Options struct {
+	// nil이면 실패한 메시지를 브로커에 NACK 합니다. (재시도와 Dead Letter 없음)
+	Retry *RetryPolicy
+
+	// 동시에 처리할 메시지 수입니다. 1 이하이면 메시지를 하나씩 순서대로 처리합니다.
+	Concurrency int
+
+	// Concurrency가 2 이상일 때 순서를 보장할 단위입니다.
+	Ordering Ordering
+
+	// nil이면 중복 메시지를 걸러내지 않습니다.
+	Dedup *DedupPolicy
+}
+
+// Ordering은 동시 처리 중에도 순서를 지킬 메시지 묶음의 기준입니다.
+type Ordering int
+
+const (
+	// 같은 파티션(Kafka)의 메시지는 순서대로 처리합니다. RabbitMQ는 순서를 보장하지 않습니다.
+	OrderByPartition Ordering = iota
+	// 같은 메시지 key(Kafka)의 메시지만 순서대로 처리합니다. 파티션 하나를 여러 worker가 나눠 처리합니다.
+	OrderByKey
+)
+
+type Option func(*Options)
+
+// WithRetry는 등록한 Consumer에 재시도/Dead Letter 정책을 지정합니다.
+func WithRetry(policy RetryPolicy) Option {
+	return func(o *Options) {
+		o.Retry = &policy
+	}
+}
+
+/*
+WithConcurrency는 등록한 Consumer를 n개의 worker로 동시에 처리합니다.
+Kafka는 Ordering 단위(기본: 파티션)별 순서를 지키고, 앞선 offset이 모두 끝난 뒤에만 커밋합니다.
+RabbitMQ는 채널 prefetch(Qos)를 n으로 설정합니다.
+*/
+func WithConcurrency(n int) Option {
+	return func(o *Options) {
+		o.Concurrency = n
+	}
+}
+
+// WithOrdering은 동시 처리 시 순서를 보장할 단위를 지정합니다.
+func WithOrdering(ordering Ordering) Option {
+	return func(o *Options) {
+		o.Ordering = ordering
+	}
+}
+
+// WithDedup은 등록한 Consumer에 중복 메시지 처리 방지 정책을 지정합니다.
+func WithDedup(policy DedupPolicy) Option {
+	return func(o *Options) {
+		o.Dedup = &policy
+	}
+}
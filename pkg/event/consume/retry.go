@@ -0,0 +1,199 @@
+package consume
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"time"
+
+	"github.com/NARUBROWN/spine/pkg/validate"
+)
+
+const (
+	defaultInitialBackoff = 100 * time.Millisecond
+	defaultMaxBackoff     = 10 * time.Second
+	defaultMultiplier     = 2.0
+)
+
+/*
+RetryPolicy는 Consumer 핸들러 실패 시의 재시도와 Dead Letter 정책입니다.
+재시도는 메시지를 브로커로 돌려보내지 않고 같은 Consumer 안에서 수행되며,
+시도 횟수를 모두 소진하거나 재시도할 수 없는 에러면 DeadLetter로 보냅니다.
+
+	consumers.Register("order.created", (*OrderConsumer).OnCreated,
+		consume.WithRetry(consume.RetryPolicy{
+			MaxAttempts:    5,
+			InitialBackoff: 200 * time.Millisecond,
+			Jitter:         0.2,
+			NonRetryable:   []error{ErrInvalidOrder},
+			DeadLetter:     "order.created.dlq",
+		}),
+	)
+*/
+type RetryPolicy struct {
+	// 첫 시도를 포함한 최대 시도 횟수입니다. 0 이하이면 1(재시도 없음)입니다.
+	MaxAttempts int
+
+	// 첫 재시도 전 대기 시간입니다. 0이면 100ms입니다.
+	InitialBackoff time.Duration
+
+	// 대기 시간 상한입니다. 0이면 10s입니다.
+	MaxBackoff time.Duration
+
+	// 재시도마다 대기 시간에 곱할 배수입니다. 1 미만이면 2입니다.
+	Multiplier float64
+
+	// 대기 시간을 무작위로 줄일 비율(0~1)입니다. 0이면 지터를 적용하지 않습니다.
+	Jitter float64
+
+	/*
+		재시도할 에러 목록입니다. errors.Is로 일치하거나 같은 타입의 에러가 체인에 있으면 해당합니다.
+		비어 있으면 NonRetryable에 해당하지 않는 모든 에러를 재시도합니다.
+	*/
+	Retryable []error
+
+	// 재시도하지 않을 에러 목록입니다. Retryable보다 우선합니다.
+	NonRetryable []error
+
+	/*
+		시도를 모두 소진한 메시지를 보낼 토픽(Kafka) 또는 큐(RabbitMQ) 이름입니다.
+		비어 있으면 메시지를 버리고(Kafka는 커밋, RabbitMQ는 requeue 없이 NACK) 로그만 남깁니다.
+	*/
+	DeadLetter string
+}
+
+// Attempts는 기본값이 반영된 최대 시도 횟수를 반환합니다.
+func (p RetryPolicy) Attempts() int {
+	if p.MaxAttempts <= 0 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// Backoff는 attempt번째 시도가 실패한 뒤 다음 시도까지 기다릴 시간을 반환합니다. (attempt는 1부터 시작)
+func (p RetryPolicy) Backoff(attempt int) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = defaultInitialBackoff
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = defaultMaxBackoff
+	}
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = defaultMultiplier
+	}
+
+	delay := float64(initial) * math.Pow(multiplier, float64(max(attempt-1, 0)))
+	if delay > float64(maxBackoff) {
+		delay = float64(maxBackoff)
+	}
+
+	if jitter := min(max(p.Jitter, 0), 1); jitter > 0 {
+		delay -= delay * jitter * rand.Float64()
+	}
+	return time.Duration(delay)
+}
+
+/*
+IsRetryable은 에러를 다시 시도할 가치가 있는지 판단합니다.
+Permanent로 감싼 에러, DTO 검증 에러(validate.Errors, validate.FieldError),
+JSON 역직렬화 에러처럼 다시 시도해도 결과가 같은 에러는 항상 재시도하지 않습니다.
+*/
+func (p RetryPolicy) IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var permanent *permanentError
+	if errors.As(err, &permanent) {
+		return false
+	}
+	if isMalformedMessage(err) {
+		return false
+	}
+
+	for _, target := range p.NonRetryable {
+		if matchesError(err, target) {
+			return false
+		}
+	}
+
+	if len(p.Retryable) == 0 {
+		return true
+	}
+	for _, target := range p.Retryable {
+		if matchesError(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Permanent는 정책과 관계없이 재시도하지 않고 바로 Dead Letter로 보낼 에러로 표시합니다.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err: err}
+}
+
+func isMalformedMessage(err error) bool {
+	var fieldErrors validate.Errors
+	var fieldError validate.FieldError
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	return errors.As(err, &fieldErrors) ||
+		errors.As(err, &fieldError) ||
+		errors.As(err, &syntaxErr) ||
+		errors.As(err, &typeErr)
+}
+
+// matchesError는 errors.Is로 일치하거나 target과 같은 타입의 에러가 체인에 있는지 확인합니다.
+func matchesError(err error, target error) bool {
+	if target == nil {
+		return false
+	}
+	if errors.Is(err, target) {
+		return true
+	}
+
+	targetType := reflect.TypeOf(target)
+	found := false
+	walkErrors(err, func(e error) bool {
+		found = reflect.TypeOf(e) == targetType
+		return found
+	})
+	return found
+}
+
+func walkErrors(err error, visit func(error) bool) bool {
+	if err == nil {
+		return false
+	}
+	if visit(err) {
+		return true
+	}
+
+	switch wrapped := err.(type) {
+	case interface{ Unwrap() error }:
+		return walkErrors(wrapped.Unwrap(), visit)
+	case interface{ Unwrap() []error }:
+		for _, inner := range wrapped.Unwrap() {
+			if walkErrors(inner, visit) {
+				return true
+			}
+		}
+	}
+	return false
+}
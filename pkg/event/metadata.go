@@ -0,0 +1,49 @@
+package event
+
+import (
+	"strings"
+	"time"
+)
+
+/*
+Metadata는 Consumer 핸들러가 받는 메시지의 브로커 메타데이터입니다.
+핸들러 파라미터로 선언하면 주입되며, 브로커에 없는 필드는 zero value로 남습니다.
+
+	func (c *OrderConsumer) OnCreated(ctx context.Context, meta event.Metadata, evt OrderCreated) error
+*/
+type Metadata struct {
+	// 메시지 헤더 (Kafka header, AMQP header table)
+	Headers map[string]string
+
+	// Kafka 메시지 key
+	Key string
+	// Kafka 파티션 번호
+	Partition int
+	// Kafka offset
+	Offset int64
+
+	// 메시지 생성(발행) 시각
+	Timestamp time.Time
+
+	// AMQP message-id
+	MessageID string
+	// AMQP correlation-id
+	CorrelationID string
+	// AMQP routing key
+	RoutingKey string
+	// 브로커가 재전달한 메시지인지 여부 (AMQP)
+	Redelivered bool
+}
+
+// Header는 헤더 값을 반환합니다. 정확히 일치하는 키가 없으면 대소문자를 무시하고 찾습니다.
+func (m Metadata) Header(name string) string {
+	if value, ok := m.Headers[name]; ok {
+		return value
+	}
+	for key, value := range m.Headers {
+		if strings.EqualFold(key, name) {
+			return value
+		}
+	}
+	return ""
+}
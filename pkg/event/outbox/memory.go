@@ -0,0 +1,101 @@
+package outbox
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+)
+
+/*
+MemoryStore는 테스트와 로컬 실행을 위한 메모리 Outbox 저장소입니다.
+트랜잭션을 지원하지 않으므로 WithTx로 지정한 트랜잭션은 무시하고 즉시 기록합니다.
+*/
+type MemoryStore struct {
+	mu      sync.Mutex
+	nextID  int64
+	records []Record
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+func (s *MemoryStore) Save(ctx context.Context, records ...Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, record := range records {
+		s.nextID++
+		record.ID = s.nextID
+		record.Headers = maps.Clone(record.Headers)
+		record.Payload = slices.Clone(record.Payload)
+		if record.CreatedAt.IsZero() {
+			record.CreatedAt = time.Now()
+		}
+		s.records = append(s.records, record)
+	}
+	return nil
+}
+
+func (s *MemoryStore) Pending(ctx context.Context, limit int, exclude ...string) ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var pending []Record
+	for _, record := range s.records {
+		if record.SentAt != nil {
+			continue
+		}
+		if record.DeliverAt != nil && record.DeliverAt.After(now) {
+			continue
+		}
+		if slices.Contains(exclude, record.AggregateID) {
+			continue
+		}
+		if limit > 0 && len(pending) >= limit {
+			break
+		}
+		pending = append(pending, record)
+	}
+	return pending, nil
+}
+
+func (s *MemoryStore) MarkSent(ctx context.Context, ids ...int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	for i := range s.records {
+		if slices.Contains(ids, s.records[i].ID) && s.records[i].SentAt == nil {
+			sentAt := now
+			s.records[i].SentAt = &sentAt
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) DeleteSent(ctx context.Context, before time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var deleted int64
+	s.records = slices.DeleteFunc(s.records, func(record Record) bool {
+		if record.SentAt != nil && record.SentAt.Before(before) {
+			deleted++
+			return true
+		}
+		return false
+	})
+	return deleted, nil
+}
+
+// Records는 저장된 모든 레코드(발행 완료 포함)의 복사본을 반환합니다.
+func (s *MemoryStore) Records() []Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return slices.Clone(s.records)
+}
@@ -0,0 +1,132 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/NARUBROWN/spine/pkg/event/codec"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+/*
+Aggregate는 이벤트가 속한 aggregate를 지정합니다.
+Relay는 같은 aggregate의 이벤트를 기록된 순서대로 발행하며, 앞선 이벤트가 실패하면 뒤 이벤트를 보류합니다.
+구현하지 않으면 publish.Keyed의 key를 aggregate로 사용합니다.
+*/
+type Aggregate interface {
+	AggregateID() string
+}
+
+// Record는 Outbox에 저장된 이벤트 한 건입니다.
+type Record struct {
+	// Store가 부여하는 증가 ID (발행 순서)
+	ID          int64
+	AggregateID string
+	EventName   string
+	// 기록할 때 Codec으로 직렬화한 본문 (형식은 Headers의 content-type)
+	Payload []byte
+	// 발행 헤더와 Codec 헤더(content-type, ce-*)
+	Headers    map[string]string
+	OccurredAt time.Time
+	CreatedAt  time.Time
+	// 지연 발행 시각 (nil이면 바로 발행). 이 시각이 지나야 Pending에 포함됩니다.
+	DeliverAt *time.Time
+	// nil이면 아직 발행되지 않은 레코드
+	SentAt *time.Time
+}
+
+type codecsKeyType struct{}
+
+var codecsKey = codecsKeyType{}
+
+// WithCodecs는 이 context로 기록되는 레코드를 codecs가 고른 Codec으로 직렬화하도록 합니다.
+// Outbox 모드에서는 Spine이 boot 설정의 Registry를 지정하므로 직접 호출할 필요가 없습니다.
+func WithCodecs(ctx context.Context, codecs *codec.Registry) context.Context {
+	return context.WithValue(ctx, codecsKey, codecs)
+}
+
+/*
+NewRecords는 이벤트를 Outbox 레코드로 바꿉니다. ctx의 발행 헤더(correlation ID 등)를 함께 기록합니다.
+본문은 바로 발행할 때와 같은 Codec(WithCodecs, 없으면 JSON / Protobuf)으로 기록 시점에 직렬화합니다.
+publish.DelayedEvent는 감싼 이벤트를 기록하고, 전달 시각은 DeliverAt으로 남깁니다.
+*/
+func NewRecords(ctx context.Context, events ...publish.DomainEvent) ([]Record, error) {
+	records := make([]Record, 0, len(events))
+	now := time.Now()
+	codecs, _ := ctx.Value(codecsKey).(*codec.Registry)
+
+	for _, event := range events {
+		event, deliverAt := publish.Delivery(event)
+
+		// Codec(ce-id)과 X-Message-Id 헤더가 같은 ID를 쓰도록 ID를 한 번만 정한다.
+		eventCtx := publish.ContextWithMessageID(ctx, publish.MessageID(ctx, event))
+		encoded, err := codecs.Encode(eventCtx, event)
+		if err != nil {
+			return nil, fmt.Errorf("outbox: failed to serialize event (%s): %w", event.Name(), err)
+		}
+		// Codec 헤더(content-type, ce-*)는 형식을 결정하므로 이벤트 헤더보다 우선한다.
+		headers := publish.MessageHeaders(eventCtx, event)
+		maps.Copy(headers, encoded.Headers)
+
+		aggregateID := publish.MessageKey(event)
+		if aggregate, ok := event.(Aggregate); ok {
+			aggregateID = aggregate.AggregateID()
+		}
+
+		record := Record{
+			AggregateID: aggregateID,
+			EventName:   event.Name(),
+			Payload:     encoded.Body,
+			Headers:     headers,
+			OccurredAt:  event.OccurredAt(),
+			CreatedAt:   now,
+		}
+		if deliverAt.After(now) {
+			record.DeliverAt = &deliverAt
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+/*
+Event는 레코드를 다시 발행 가능한 이벤트로 바꿉니다.
+기록할 때 직렬화한 본문과 헤더를 그대로 발행하고, aggregate ID를 메시지 key로 지정해 브로커에서도 순서가 유지되게 합니다.
+*/
+func (r Record) Event() publish.DomainEvent {
+	return storedEvent{record: r}
+}
+
+type storedEvent struct {
+	record Record
+}
+
+func (e storedEvent) Name() string {
+	return e.record.EventName
+}
+
+func (e storedEvent) OccurredAt() time.Time {
+	return e.record.OccurredAt
+}
+
+func (e storedEvent) Key() string {
+	return e.record.AggregateID
+}
+
+func (e storedEvent) Headers() map[string]string {
+	return maps.Clone(e.record.Headers)
+}
+
+func (e storedEvent) EncodedMessage() codec.Message {
+	headers := maps.Clone(e.record.Headers)
+	if headers == nil {
+		headers = make(map[string]string, 1)
+	}
+	// content-type 없이 기록된 레코드는 JSON 본문이다.
+	if _, ok := headers[codec.ContentTypeHeader]; !ok {
+		headers[codec.ContentTypeHeader] = codec.JSON.ContentType()
+	}
+	return codec.Message{Body: e.record.Payload, Headers: headers}
+}
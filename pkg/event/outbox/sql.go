@@ -0,0 +1,225 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/*
+SQLOptions는 SQLStore 설정입니다.
+
+기본 테이블 스키마 (PostgreSQL 기준, 다른 DB는 타입만 맞추면 됩니다)
+
+	CREATE TABLE spine_outbox (
+		id           BIGSERIAL PRIMARY KEY,
+		aggregate_id VARCHAR(255) NOT NULL,
+		event_name   VARCHAR(255) NOT NULL,
+		payload      BYTEA        NOT NULL,
+		headers      TEXT         NOT NULL,
+		occurred_at  TIMESTAMPTZ  NOT NULL,
+		created_at   TIMESTAMPTZ  NOT NULL,
+		deliver_at   TIMESTAMPTZ  NULL,
+		sent_at      TIMESTAMPTZ  NULL
+	);
+	CREATE INDEX spine_outbox_unsent ON spine_outbox (id) WHERE sent_at IS NULL;
+
+지연 발행 이전에 만든 테이블은 deliver_at 컬럼을 추가해야 합니다.
+
+	ALTER TABLE spine_outbox ADD COLUMN deliver_at TIMESTAMPTZ NULL;
+*/
+type SQLOptions struct {
+	// Outbox 테이블 이름입니다. 빈 값이면 "spine_outbox"를 사용합니다.
+	Table string
+
+	// n번째(1부터) 바인드 파라미터 표기를 반환합니다.
+	// nil이면 "?"(MySQL, SQLite)를 사용하고, PostgreSQL은 DollarPlaceholder를 지정합니다.
+	Placeholder func(n int) string
+}
+
+// DollarPlaceholder는 PostgreSQL 형식($1, $2, ...)의 바인드 파라미터 표기입니다.
+func DollarPlaceholder(n int) string {
+	return "$" + strconv.Itoa(n)
+}
+
+func questionPlaceholder(int) string {
+	return "?"
+}
+
+/*
+SQLStore는 database/sql 기반 Outbox 저장소입니다.
+Save는 ctx에 WithTx로 지정한 트랜잭션이 있으면 그 안에서, 없으면 db에 바로 기록합니다.
+Pending은 행을 잠그거나 점유하지 않으므로, 여러 인스턴스로 배포할 때도 relay는 하나만 켜야 합니다.
+*/
+type SQLStore struct {
+	db          *sql.DB
+	table       string
+	placeholder func(n int) string
+}
+
+func NewSQLStore(db *sql.DB, opts SQLOptions) (*SQLStore, error) {
+	if db == nil {
+		return nil, errors.New("outbox: sql.DB cannot be nil")
+	}
+
+	table := opts.Table
+	if table == "" {
+		table = "spine_outbox"
+	}
+	placeholder := opts.Placeholder
+	if placeholder == nil {
+		placeholder = questionPlaceholder
+	}
+
+	return &SQLStore{db: db, table: table, placeholder: placeholder}, nil
+}
+
+func (s *SQLStore) Save(ctx context.Context, records ...Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	var exec Executor = s.db
+	if tx, ok := TxFromContext(ctx); ok {
+		exec = tx
+	}
+
+	query := fmt.Sprintf(
+		"INSERT INTO %s (aggregate_id, event_name, payload, headers, occurred_at, created_at, deliver_at) VALUES (%s)",
+		s.table, s.placeholders(1, 7),
+	)
+
+	for _, record := range records {
+		headers, err := encodeHeaders(record.Headers)
+		if err != nil {
+			return err
+		}
+		createdAt := record.CreatedAt
+		if createdAt.IsZero() {
+			createdAt = time.Now()
+		}
+
+		if _, err := exec.ExecContext(ctx, query,
+			record.AggregateID, record.EventName, record.Payload, headers, record.OccurredAt, createdAt, record.DeliverAt,
+		); err != nil {
+			return fmt.Errorf("outbox: failed to save event (%s): %w", record.EventName, err)
+		}
+	}
+	return nil
+}
+
+func (s *SQLStore) Pending(ctx context.Context, limit int, exclude ...string) ([]Record, error) {
+	args := make([]any, 0, len(exclude)+2)
+	args = append(args, time.Now())
+
+	excluded := ""
+	if len(exclude) > 0 {
+		excluded = fmt.Sprintf(" AND aggregate_id NOT IN (%s)", s.placeholders(2, len(exclude)))
+		for _, aggregateID := range exclude {
+			args = append(args, aggregateID)
+		}
+	}
+	args = append(args, limit)
+
+	query := fmt.Sprintf(
+		"SELECT id, aggregate_id, event_name, payload, headers, occurred_at, created_at, deliver_at FROM %s "+
+			"WHERE sent_at IS NULL AND (deliver_at IS NULL OR deliver_at <= %s)%s ORDER BY id LIMIT %s",
+		s.table, s.placeholder(1), excluded, s.placeholder(len(args)),
+	)
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("outbox: failed to query pending events: %w", err)
+	}
+	defer rows.Close()
+
+	var records []Record
+	for rows.Next() {
+		var record Record
+		var headers string
+		if err := rows.Scan(
+			&record.ID, &record.AggregateID, &record.EventName, &record.Payload,
+			&headers, &record.OccurredAt, &record.CreatedAt, &record.DeliverAt,
+		); err != nil {
+			return nil, fmt.Errorf("outbox: failed to scan pending event: %w", err)
+		}
+		if record.Headers, err = decodeHeaders(headers); err != nil {
+			return nil, err
+		}
+		records = append(records, record)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("outbox: failed to read pending events: %w", err)
+	}
+	return records, nil
+}
+
+func (s *SQLStore) MarkSent(ctx context.Context, ids ...int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := fmt.Sprintf(
+		"UPDATE %s SET sent_at = %s WHERE id IN (%s)",
+		s.table, s.placeholder(1), s.placeholders(2, len(ids)),
+	)
+	args := make([]any, 0, len(ids)+1)
+	args = append(args, time.Now())
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	if _, err := s.db.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("outbox: failed to mark events as sent: %w", err)
+	}
+	return nil
+}
+
+func (s *SQLStore) DeleteSent(ctx context.Context, before time.Time) (int64, error) {
+	query := fmt.Sprintf(
+		"DELETE FROM %s WHERE sent_at IS NOT NULL AND sent_at < %s",
+		s.table, s.placeholder(1),
+	)
+
+	result, err := s.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, fmt.Errorf("outbox: failed to delete sent events: %w", err)
+	}
+	return result.RowsAffected()
+}
+
+// placeholders는 from번째부터 count개의 바인드 파라미터를 ", "로 이어 반환합니다.
+func (s *SQLStore) placeholders(from, count int) string {
+	parts := make([]string, count)
+	for i := range parts {
+		parts[i] = s.placeholder(from + i)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func encodeHeaders(headers map[string]string) (string, error) {
+	if len(headers) == 0 {
+		return "{}", nil
+	}
+	encoded, err := json.Marshal(headers)
+	if err != nil {
+		return "", fmt.Errorf("outbox: failed to encode headers: %w", err)
+	}
+	return string(encoded), nil
+}
+
+func decodeHeaders(encoded string) (map[string]string, error) {
+	if encoded == "" || encoded == "{}" {
+		return nil, nil
+	}
+	var headers map[string]string
+	if err := json.Unmarshal([]byte(encoded), &headers); err != nil {
+		return nil, fmt.Errorf("outbox: failed to decode headers: %w", err)
+	}
+	return headers, nil
+}
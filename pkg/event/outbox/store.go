@@ -0,0 +1,105 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+/*
+Store는 발행할 이벤트를 보관하는 Outbox 저장소입니다.
+boot.Options.Outbox를 켜고 구현체를 생성자로 등록하면, Spine이 백그라운드 relay로
+미발행 레코드를 읽어 기존 Publisher(Kafka, RabbitMQ)로 발행합니다.
+
+Pending은 레코드를 점유하지 않으므로, 같은 Store를 읽는 relay는 하나만 실행해야 합니다.
+여러 인스턴스가 같은 테이블의 relay를 실행하면 같은 레코드가 중복 발행됩니다.
+*/
+type Store interface {
+	// Save는 레코드를 기록합니다. ctx에 WithTx로 트랜잭션이 있으면 그 트랜잭션 안에서 기록해야 합니다.
+	Save(ctx context.Context, records ...Record) error
+	// Pending은 아직 발행되지 않았고 DeliverAt이 지난(또는 없는) 레코드를 ID 오름차순으로 최대 limit개 반환합니다.
+	// exclude에 포함된 aggregate의 레코드는 제외합니다. (발행이 막힌 aggregate가 배치를 채우지 않도록)
+	Pending(ctx context.Context, limit int, exclude ...string) ([]Record, error)
+	// MarkSent는 레코드를 발행 완료로 표시합니다.
+	MarkSent(ctx context.Context, ids ...int64) error
+	// DeleteSent는 before 이전에 발행 완료된 레코드를 삭제하고 삭제한 개수를 반환합니다.
+	DeleteSent(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Executor는 Outbox 레코드를 기록할 수 있는 SQL 실행기입니다. *sql.Tx와 *sql.DB가 구현합니다.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+type txKeyType struct{}
+
+var txKey = txKeyType{}
+
+// WithTx는 이 context로 기록되는 Outbox 레코드가 tx 안에서 기록되도록 합니다.
+func WithTx(ctx context.Context, tx Executor) context.Context {
+	return context.WithValue(ctx, txKey, tx)
+}
+
+// TxFromContext는 WithTx로 지정한 트랜잭션을 반환합니다.
+func TxFromContext(ctx context.Context) (Executor, bool) {
+	tx, ok := ctx.Value(txKey).(Executor)
+	return tx, ok && tx != nil
+}
+
+/*
+Append는 이벤트를 Outbox에 기록합니다.
+도메인 변경과 같은 트랜잭션으로 기록하면, 커밋된 변경의 이벤트만 (최소 한 번) 발행됩니다.
+
+	tx, _ := db.BeginTx(ctx, nil)
+	// ... 도메인 변경
+	if err := outbox.Append(outbox.WithTx(ctx, tx), store, OrderCreated{...}); err != nil {
+		tx.Rollback()
+		return err
+	}
+	tx.Commit()
+*/
+func Append(ctx context.Context, store Store, events ...publish.DomainEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	records, err := NewRecords(ctx, events...)
+	if err != nil {
+		return err
+	}
+	return store.Save(ctx, records...)
+}
+
+// drainer는 실행 컨텍스트의 이벤트 버스에서 모인 이벤트를 꺼냅니다. (core.EventBus)
+type drainer interface {
+	Drain() []publish.DomainEvent
+}
+
+/*
+Flush는 ctx의 이벤트 버스에 지금까지 모인 이벤트를 꺼내 Outbox에 기록합니다.
+Scoped Tx 대신 직접 관리하는 트랜잭션에 기록하려면 커밋 전에 WithTx와 함께 호출합니다.
+꺼낸 이벤트는 버스에서 빠지므로 요청이 끝난 뒤 다시 기록되지 않습니다.
+
+	func (c *OrderController) Create(ctx context.Context, req CreateOrder) error {
+		tx, _ := c.db.BeginTx(ctx, nil)
+		defer tx.Rollback()
+		// ... 도메인 변경
+		publish.Event(ctx, OrderCreated{...})
+		if err := outbox.Flush(outbox.WithTx(ctx, tx), c.store); err != nil {
+			return err
+		}
+		return tx.Commit()
+	}
+
+ctx는 컨트롤러가 주입받은 context.Context(또는 그 파생)여야 합니다.
+correlation ID 같은 발행 헤더는 publish.ContextWithHeaders로 지정한 값이 기록됩니다.
+*/
+func Flush(ctx context.Context, store Store) error {
+	bus, ok := ctx.Value(publish.PublisherKey).(drainer)
+	if !ok || bus == nil {
+		return nil
+	}
+	return Append(ctx, store, bus.Drain()...)
+}
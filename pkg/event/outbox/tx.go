@@ -0,0 +1,90 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sync"
+)
+
+/*
+Tx는 실행 단위(Scoped)로 등록하는 Outbox 트랜잭션입니다.
+Outbox 모드에서 Tx를 Scoped로 등록하면, 요청 처리 중 발행된 이벤트는 이 트랜잭션 안에서 기록되고
+실행 스코프가 닫힐 때 실행이 성공했으면 Commit, 실패했으면 Rollback 됩니다.
+도메인 변경도 같은 Tx로 기록하면 이벤트와 도메인 변경이 함께 커밋됩니다.
+
+	app.Constructor(di.Scoped(outbox.NewTx))
+
+	func NewOrderRepository(tx *outbox.Tx) *OrderRepository { ... }
+
+	func (r *OrderRepository) Save(ctx context.Context, order Order) error {
+		_, err := r.tx.ExecContext(ctx, "INSERT INTO orders ...", order.ID)
+		return err
+	}
+
+트랜잭션은 처음 사용할 때 시작하므로, DB를 쓰지 않는 실행은 트랜잭션을 열지 않습니다.
+*/
+type Tx struct {
+	ctx  context.Context
+	db   *sql.DB
+	mu   sync.Mutex
+	tx   *sql.Tx
+	done bool
+}
+
+// NewTx는 실행 context로 시작할 Tx를 만듭니다. di.Scoped로 등록해 사용합니다.
+func NewTx(ctx context.Context, db *sql.DB) *Tx {
+	return &Tx{ctx: ctx, db: db}
+}
+
+// Begin은 트랜잭션을 시작해 반환합니다. 이미 시작했으면 같은 트랜잭션을 반환합니다.
+func (t *Tx) Begin() (*sql.Tx, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.done {
+		return nil, errors.New("outbox: transaction is already finished")
+	}
+	if t.tx == nil {
+		if t.db == nil {
+			return nil, errors.New("outbox: sql.DB cannot be nil")
+		}
+		tx, err := t.db.BeginTx(t.ctx, nil)
+		if err != nil {
+			return nil, err
+		}
+		t.tx = tx
+	}
+	return t.tx, nil
+}
+
+func (t *Tx) ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	tx, err := t.Begin()
+	if err != nil {
+		return nil, err
+	}
+	return tx.ExecContext(ctx, query, args...)
+}
+
+func (t *Tx) QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	tx, err := t.Begin()
+	if err != nil {
+		return nil, err
+	}
+	return tx.QueryContext(ctx, query, args...)
+}
+
+// Dispose는 실행 스코프가 닫힐 때 호출됩니다. err가 없으면 Commit, 있으면 Rollback 합니다.
+func (t *Tx) Dispose(err error) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.done = true
+	if t.tx == nil {
+		return nil
+	}
+	if err != nil {
+		return t.tx.Rollback()
+	}
+	return t.tx.Commit()
+}
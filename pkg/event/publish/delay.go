@@ -0,0 +1,68 @@
+package publish
+
+import "time"
+
+/*
+Scheduled는 이벤트를 지정한 시각 이후에 전달하도록 합니다.
+DeliverAt이 zero time이거나 이미 지났으면 즉시 발행합니다.
+
+	func (e OrderExpired) DeliverAt() time.Time { return e.CreatedAt.Add(30 * time.Minute) }
+*/
+type Scheduled interface {
+	DeliverAt() time.Time
+}
+
+/*
+DelayedEvent는 이벤트 타입을 바꾸지 않고 전달 시각을 지정하는 래퍼입니다.
+Consumer에는 감싼 이벤트(Event)가 그대로 전달됩니다.
+
+	publish.Event(ctx, publish.Delay(OrderExpired{OrderID: id}, 30*time.Minute))
+
+Outbox를 사용하면 전달 시각이 Store에 기록되므로 재시작 후에도 유지되며,
+그렇지 않으면 Publisher(Kafka, RabbitMQ, In-Memory)의 지연 전달 기능을 사용합니다.
+*/
+type DelayedEvent struct {
+	Event DomainEvent
+	At    time.Time
+}
+
+// Delay는 지금으로부터 d 이후에 전달할 이벤트를 만듭니다.
+func Delay(event DomainEvent, d time.Duration) DelayedEvent {
+	return DelayedEvent{Event: event, At: time.Now().Add(d)}
+}
+
+// DelayUntil은 at 이후에 전달할 이벤트를 만듭니다.
+func DelayUntil(event DomainEvent, at time.Time) DelayedEvent {
+	return DelayedEvent{Event: event, At: at}
+}
+
+func (e DelayedEvent) Name() string {
+	return e.Event.Name()
+}
+
+func (e DelayedEvent) OccurredAt() time.Time {
+	return e.Event.OccurredAt()
+}
+
+func (e DelayedEvent) DeliverAt() time.Time {
+	return e.At
+}
+
+/*
+Delivery는 DelayedEvent 래퍼를 벗긴 실제 이벤트와 전달 시각을 반환합니다.
+전달 시각을 지정하지 않은 이벤트는 zero time을 반환합니다.
+*/
+func Delivery(event DomainEvent) (DomainEvent, time.Time) {
+	if delayed, ok := event.(DelayedEvent); ok {
+		inner, at := Delivery(delayed.Event)
+		if delayed.At.After(at) {
+			at = delayed.At
+		}
+		return inner, at
+	}
+
+	if scheduled, ok := event.(Scheduled); ok {
+		return event, scheduled.DeliverAt()
+	}
+	return event, time.Time{}
+}
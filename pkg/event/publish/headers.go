@@ -0,0 +1,125 @@
+package publish
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"maps"
+)
+
+// 발행 메시지에 자동으로 전파되는 헤더 이름
+const (
+	CorrelationIDHeader = "X-Correlation-Id"
+	TraceParentHeader   = "traceparent"
+	// 메시지마다 붙는 고유 ID (Consumer 중복 처리 방지에 사용)
+	MessageIDHeader = "X-Message-Id"
+)
+
+/*
+Keyed는 이벤트의 파티션 key를 지정합니다.
+Kafka는 같은 key의 메시지를 같은 파티션에 기록하므로, 관련 이벤트의 순서가 유지됩니다.
+
+	func (e OrderCreated) Key() string { return e.OrderID }
+*/
+type Keyed interface {
+	Key() string
+}
+
+/*
+Identified는 이벤트의 고유 ID를 지정합니다.
+X-Message-Id 헤더와 CloudEvents id로 사용되며, 구현하지 않으면 발행할 때마다 새 ID를 만듭니다.
+같은 이벤트를 다시 발행해도 ID가 같으므로 Consumer가 중복을 걸러낼 수 있습니다.
+*/
+type Identified interface {
+	EventID() string
+}
+
+// WithHeaders는 이벤트별 메시지 헤더를 지정합니다. 같은 이름이면 context 헤더보다 우선합니다.
+type WithHeaders interface {
+	Headers() map[string]string
+}
+
+type headersKeyType struct{}
+
+var headersKey = headersKeyType{}
+
+type messageIDKeyType struct{}
+
+var messageIDKey = messageIDKeyType{}
+
+// ContextWithHeaders는 이 context로 발행되는 모든 이벤트에 붙일 헤더를 추가합니다.
+func ContextWithHeaders(ctx context.Context, headers map[string]string) context.Context {
+	merged := maps.Clone(HeadersFromContext(ctx))
+	if merged == nil {
+		merged = make(map[string]string, len(headers))
+	}
+	maps.Copy(merged, headers)
+	return context.WithValue(ctx, headersKey, merged)
+}
+
+// HeadersFromContext는 ContextWithHeaders로 추가한 헤더를 반환합니다.
+func HeadersFromContext(ctx context.Context) map[string]string {
+	headers, _ := ctx.Value(headersKey).(map[string]string)
+	return headers
+}
+
+// ContextWithMessageID는 이 context로 발행되는 이벤트의 메시지 ID를 고정합니다.
+// Dispatcher는 이벤트마다 ID를 한 번 만들어, 여러 Publisher가 같은 ID로 발행하게 합니다.
+func ContextWithMessageID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, messageIDKey, id)
+}
+
+/*
+MessageHeaders는 context 헤더와 이벤트 헤더(WithHeaders)를 합친 발행 헤더를 반환합니다.
+X-Message-Id 헤더는 MessageID로 채웁니다.
+*/
+func MessageHeaders(ctx context.Context, event DomainEvent) map[string]string {
+	headers := maps.Clone(HeadersFromContext(ctx))
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+	if withHeaders, ok := event.(WithHeaders); ok {
+		maps.Copy(headers, withHeaders.Headers())
+	}
+	headers[MessageIDHeader] = MessageID(ctx, event)
+	return headers
+}
+
+/*
+MessageID는 이벤트의 메시지 ID를 다음 순서로 찾아 반환합니다.
+  - ContextWithMessageID로 고정한 ID
+  - 이벤트 헤더(WithHeaders), context 헤더의 X-Message-Id
+  - 이벤트가 구현한 Identified의 ID
+
+모두 없으면 새 ID를 만듭니다.
+*/
+func MessageID(ctx context.Context, event DomainEvent) string {
+	if id, _ := ctx.Value(messageIDKey).(string); id != "" {
+		return id
+	}
+	if withHeaders, ok := event.(WithHeaders); ok {
+		if id := withHeaders.Headers()[MessageIDHeader]; id != "" {
+			return id
+		}
+	}
+	if id := HeadersFromContext(ctx)[MessageIDHeader]; id != "" {
+		return id
+	}
+	if identified, ok := event.(Identified); ok {
+		if id := identified.EventID(); id != "" {
+			return id
+		}
+	}
+
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+// MessageKey는 이벤트가 Keyed를 구현하면 그 key를, 아니면 빈 문자열을 반환합니다.
+func MessageKey(event DomainEvent) string {
+	if keyed, ok := event.(Keyed); ok {
+		return keyed.Key()
+	}
+	return ""
+}
@@ -0,0 +1,45 @@
+package publish
+
+import (
+	"context"
+	"time"
+)
+
+// 요청/응답(Request/Reply) 메시지의 헤더 이름
+const (
+	// 응답을 보낼 곳 (Kafka/In-Memory는 Topic, RabbitMQ는 AMQP ReplyTo 속성에도 설정)
+	ReplyToHeader = "X-Reply-To"
+	// 요청과 응답을 짝짓는 ID (RabbitMQ는 AMQP CorrelationId 속성에도 설정)
+	ReplyCorrelationHeader = "X-Reply-Correlation-Id"
+	// 호출자가 응답을 기다리는 마감 시각 (RFC3339Nano)
+	RequestDeadlineHeader = "X-Request-Deadline"
+	// Consumer가 요청을 처리하지 못했을 때 응답에 담기는 에러 메시지
+	// (httperr.HTTPError의 메시지이거나, 그 외에는 내부 에러를 감춘 일반 메시지입니다)
+	ReplyErrorHeader = "X-Reply-Error"
+)
+
+// DefaultRequestTimeout은 context에 마감 시각이 없을 때 응답을 기다리는 시간입니다.
+const DefaultRequestTimeout = 30 * time.Second
+
+/*
+Requester는 이벤트를 요청으로 발행하고 Consumer의 응답을 기다립니다.
+Controller 생성자에서 주입받아 사용합니다.
+
+	quote, err := c.bus.Request(ctx, PriceQuoteRequested{SKU: sku})
+
+응답은 요청을 처리한 Consumer 핸들러의 에러가 아닌 반환값입니다. (struct는 JSON, []byte와 string은 그대로 전달)
+ctx의 마감 시각까지 기다리며, 마감 시각은 메시지에 실려 Consumer 핸들러의 context에도 적용됩니다.
+마감 시각이 없으면 DefaultRequestTimeout을 사용하고, Consumer가 재시도를 모두 소진하면 *ReplyError를 반환합니다.
+*/
+type Requester interface {
+	Request(ctx context.Context, event DomainEvent) ([]byte, error)
+}
+
+// ReplyError는 Consumer가 요청을 처리하지 못했다는 응답입니다.
+type ReplyError struct {
+	Message string
+}
+
+func (e *ReplyError) Error() string {
+	return "request failed: " + e.Message
+}
@@ -1,9 +1,15 @@
 package httperr
 
+import "strings"
+
 type HTTPError struct {
 	Status  int
 	Message string
 	Cause   error
+	// Details는 응답 본문의 "details" 필드로 함께 내려가는 구조화된 정보입니다. (예: 필드별 검증 에러)
+	Details any
+	// Headers는 에러 응답에 함께 설정되는 헤더입니다. (예: 405의 Allow)
+	Headers map[string]string
 }
 
 // error 인터페이스의 계약 구현
@@ -11,6 +17,11 @@ func (e *HTTPError) Error() string {
 	return e.Message
 }
 
+// Unwrap은 errors.Is / errors.As가 원인 에러를 따라갈 수 있도록 합니다.
+func (e *HTTPError) Unwrap() error {
+	return e.Cause
+}
+
 func NotFound(msg string) error {
 	return &HTTPError{Status: 404, Message: msg}
 }
@@ -23,6 +34,15 @@ func Unauthorized(msg string) error {
 	return &HTTPError{Status: 401, Message: msg}
 }
 
+// MethodNotAllowed는 경로는 있지만 메서드가 허용되지 않을 때의 405 에러를 만들고, Allow 헤더를 채웁니다.
+func MethodNotAllowed(msg string, allowed ...string) error {
+	return &HTTPError{
+		Status:  405,
+		Message: msg,
+		Headers: map[string]string{"Allow": strings.Join(allowed, ", ")},
+	}
+}
+
 func InternalServerError(msg string) error {
 	return &HTTPError{Status: 500, Message: msg}
 }
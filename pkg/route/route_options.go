@@ -1,6 +1,8 @@
 package route
 
 import (
+	"reflect"
+
 	"github.com/NARUBROWN/spine/core"
 	"github.com/NARUBROWN/spine/internal/router"
 )
@@ -10,3 +12,54 @@ func WithInterceptors(interceptors ...core.Interceptor) router.RouteOption {
 		rs.Interceptors = append(rs.Interceptors, interceptors...)
 	}
 }
+
+// WithSummary는 OpenAPI 문서의 operation 요약을 지정합니다.
+func WithSummary(summary string) router.RouteOption {
+	return func(rs *router.RouteSpec) {
+		rs.Doc.Summary = summary
+	}
+}
+
+// WithDescription은 OpenAPI 문서의 operation 상세 설명을 지정합니다.
+func WithDescription(description string) router.RouteOption {
+	return func(rs *router.RouteSpec) {
+		rs.Doc.Description = description
+	}
+}
+
+// WithTags는 OpenAPI 문서에서 라우트를 묶을 태그를 지정합니다.
+func WithTags(tags ...string) router.RouteOption {
+	return func(rs *router.RouteSpec) {
+		rs.Doc.Tags = append(rs.Doc.Tags, tags...)
+	}
+}
+
+// Deprecated는 라우트를 OpenAPI 문서에서 deprecated로 표시합니다.
+func Deprecated() router.RouteOption {
+	return func(rs *router.RouteSpec) {
+		rs.Doc.Deprecated = true
+	}
+}
+
+// WithHeaders는 header.Values로 읽는 요청 헤더를 문서화합니다.
+func WithHeaders(names ...string) router.RouteOption {
+	return func(rs *router.RouteSpec) {
+		rs.Doc.Headers = append(rs.Doc.Headers, names...)
+	}
+}
+
+/*
+WithResponse는 반환 타입으로 드러나지 않는 응답(에러 상태 코드 등)을 문서화합니다.
+body는 응답 본문 예시 값이며, nil이면 본문 없는 응답으로 기록됩니다.
+
+	route.WithResponse(404, "사용자 없음", ErrorBody{})
+*/
+func WithResponse(status int, description string, body any) router.RouteOption {
+	return func(rs *router.RouteSpec) {
+		rs.Doc.Responses = append(rs.Doc.Responses, router.ResponseDoc{
+			Status:      status,
+			Description: description,
+			BodyType:    reflect.TypeOf(body),
+		})
+	}
+}
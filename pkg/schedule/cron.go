@@ -0,0 +1,274 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule은 작업의 다음 실행 시각을 계산합니다.
+type Schedule interface {
+	// Next는 t 이후의 다음 실행 시각을 반환합니다. 더 이상 실행할 시각이 없으면 zero time을 반환합니다.
+	Next(t time.Time) time.Time
+}
+
+// Parse는 cron 표현식을 Schedule로 변환합니다.
+//
+//   - 5필드: 분 시 일 월 요일 (예: "0 */5 * * *")
+//   - 6필드: 초 분 시 일 월 요일 (예: "30 0 9 * * MON-FRI")
+//   - 단축 표현: @yearly(@annually), @monthly, @weekly, @daily(@midnight), @hourly
+//   - 고정 간격: @every 1h30m
+//
+// 각 필드는 *, ?, 값, 범위(a-b), 간격(*/n, a-b/n, a/n), 목록(a,b)을 지원하며,
+// 월과 요일은 JAN-DEC, SUN-SAT 이름도 사용할 수 있습니다. (요일 0과 7은 일요일)
+// 일과 요일을 모두 지정하면 둘 중 하나만 맞아도 실행합니다.
+func Parse(spec string) (Schedule, error) {
+	spec = strings.TrimSpace(spec)
+	if spec == "" {
+		return nil, fmt.Errorf("schedule: empty spec")
+	}
+
+	if strings.HasPrefix(spec, "@every ") {
+		interval, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(spec, "@every ")))
+		if err != nil {
+			return nil, fmt.Errorf("schedule: invalid interval in %q: %w", spec, err)
+		}
+		if interval <= 0 {
+			return nil, fmt.Errorf("schedule: interval must be positive: %q", spec)
+		}
+		return Every(interval), nil
+	}
+
+	if strings.HasPrefix(spec, "@") {
+		expanded, ok := descriptors[spec]
+		if !ok {
+			return nil, fmt.Errorf("schedule: unknown descriptor %q", spec)
+		}
+		return parseFields(spec, strings.Fields(expanded))
+	}
+
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		// 5필드 표현식은 매 분 0초에 실행한다.
+		return parseFields(spec, append([]string{"0"}, fields...))
+	case 6:
+		return parseFields(spec, fields)
+	default:
+		return nil, fmt.Errorf("schedule: expected 5 or 6 fields, got %d: %q", len(fields), spec)
+	}
+}
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// Every는 interval 간격으로 반복되는 Schedule을 만듭니다.
+func Every(interval time.Duration) Schedule {
+	return everySchedule{interval: interval}
+}
+
+type everySchedule struct {
+	interval time.Duration
+}
+
+func (s everySchedule) Next(t time.Time) time.Time {
+	return t.Add(s.interval)
+}
+
+type fieldBounds struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var (
+	secondBounds = fieldBounds{name: "second", min: 0, max: 59}
+	minuteBounds = fieldBounds{name: "minute", min: 0, max: 59}
+	hourBounds   = fieldBounds{name: "hour", min: 0, max: 23}
+	dayBounds    = fieldBounds{name: "day of month", min: 1, max: 31}
+	monthBounds  = fieldBounds{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}}
+	// 7은 일요일(0)의 별칭으로 받는다.
+	weekdayBounds = fieldBounds{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}}
+)
+
+// cronSchedule은 필드별로 허용되는 값을 비트 집합으로 보관합니다.
+type cronSchedule struct {
+	second, minute, hour, day, month, weekday uint64
+	// 일/요일 필드가 *(또는 ?)인지 여부. 둘 다 제한되면 OR로 판단한다.
+	anyDay, anyWeekday bool
+}
+
+func parseFields(spec string, fields []string) (Schedule, error) {
+	s := &cronSchedule{}
+	targets := []struct {
+		bits   *uint64
+		any    *bool
+		bounds fieldBounds
+	}{
+		{bits: &s.second, bounds: secondBounds},
+		{bits: &s.minute, bounds: minuteBounds},
+		{bits: &s.hour, bounds: hourBounds},
+		{bits: &s.day, any: &s.anyDay, bounds: dayBounds},
+		{bits: &s.month, bounds: monthBounds},
+		{bits: &s.weekday, any: &s.anyWeekday, bounds: weekdayBounds},
+	}
+
+	for i, target := range targets {
+		bits, wildcard, err := parseField(fields[i], target.bounds)
+		if err != nil {
+			return nil, fmt.Errorf("schedule: invalid %s field in %q: %w", target.bounds.name, spec, err)
+		}
+		*target.bits = bits
+		if target.any != nil {
+			*target.any = wildcard
+		}
+	}
+
+	if s.weekday&(1<<7) != 0 {
+		s.weekday = s.weekday&^(1<<7) | 1
+	}
+	return s, nil
+}
+
+// parseField는 쉼표로 구분된 필드를 비트 집합으로 변환하고, 필드 전체가 *인지 함께 반환합니다.
+func parseField(field string, bounds fieldBounds) (uint64, bool, error) {
+	if field == "*" || field == "?" {
+		return bitRange(bounds.min, bounds.max, 1), true, nil
+	}
+
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		partBits, err := parsePart(part, bounds)
+		if err != nil {
+			return 0, false, err
+		}
+		bits |= partBits
+	}
+	return bits, false, nil
+}
+
+func parsePart(part string, bounds fieldBounds) (uint64, error) {
+	rangePart, stepPart, hasStep := strings.Cut(part, "/")
+
+	step := 1
+	if hasStep {
+		var err error
+		step, err = strconv.Atoi(stepPart)
+		if err != nil || step <= 0 {
+			return 0, fmt.Errorf("invalid step %q", part)
+		}
+	}
+
+	var start, end int
+	switch {
+	case rangePart == "*" || rangePart == "?":
+		start, end = bounds.min, bounds.max
+	case strings.Contains(rangePart, "-"):
+		low, high, _ := strings.Cut(rangePart, "-")
+		var err error
+		if start, err = parseValue(low, bounds); err != nil {
+			return 0, err
+		}
+		if end, err = parseValue(high, bounds); err != nil {
+			return 0, err
+		}
+		if start > end {
+			return 0, fmt.Errorf("invalid range %q", part)
+		}
+	default:
+		value, err := parseValue(rangePart, bounds)
+		if err != nil {
+			return 0, err
+		}
+		start, end = value, value
+		// "a/n"은 a부터 최댓값까지 n 간격을 의미한다.
+		if hasStep {
+			end = bounds.max
+		}
+	}
+
+	return bitRange(start, end, step), nil
+}
+
+func parseValue(value string, bounds fieldBounds) (int, error) {
+	if n, ok := bounds.names[strings.ToUpper(value)]; ok {
+		return n, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", value)
+	}
+	if n < bounds.min || n > bounds.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", n, bounds.min, bounds.max)
+	}
+	return n, nil
+}
+
+func bitRange(start, end, step int) uint64 {
+	var bits uint64
+	for i := start; i <= end; i += step {
+		bits |= 1 << uint(i)
+	}
+	return bits
+}
+
+func has(bits uint64, value int) bool {
+	return bits&(1<<uint(value)) != 0
+}
+
+// 조건을 만족하는 시각이 이 기간 안에 없으면(예: 2월 30일) 더 이상 실행하지 않는다.
+const searchLimitYears = 5
+
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = t.Truncate(time.Second).Add(time.Second)
+	limit := t.Year() + searchLimitYears
+
+	for t.Year() <= limit {
+		if !has(s.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !has(s.hour, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if !has(s.minute, t.Minute()) {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+		if !has(s.second, t.Second()) {
+			t = t.Add(time.Second)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	dayMatch := has(s.day, t.Day())
+	weekdayMatch := has(s.weekday, int(t.Weekday()))
+	if s.anyDay || s.anyWeekday {
+		return dayMatch && weekdayMatch
+	}
+	return dayMatch || weekdayMatch
+}
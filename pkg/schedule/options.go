@@ -0,0 +1,54 @@
+package schedule
+
+import (
+	"time"
+
+	"github.com/NARUBROWN/spine/core"
+)
+
+// Options는 예약 작업 등록 단위 설정입니다.
+type Options struct {
+	// 작업 이름입니다. 비어 있으면 "Controller.Method"를 사용합니다.
+	Name string
+
+	// 매 실행 전 0 ~ Jitter 사이의 임의 지연을 더합니다. 여러 인스턴스의 동시 실행을 분산할 때 사용합니다.
+	// 지연은 그 실행에만 적용되며, 다음 실행 시각은 원래 일정대로 계산합니다.
+	Jitter time.Duration
+
+	// 한 번의 실행에 허용하는 시간입니다. 0이면 제한하지 않습니다.
+	// 초과하면 핸들러의 context.Context가 취소되므로, 핸들러는 ctx를 확인해야 합니다.
+	Timeout time.Duration
+
+	// 작업 실행에 적용할 Interceptor입니다. (nil 포인터는 컨테이너에서 생성)
+	Interceptors []core.Interceptor
+}
+
+type Option func(*Options)
+
+// WithName은 로그와 라우팅에 사용할 작업 이름을 지정합니다.
+func WithName(name string) Option {
+	return func(o *Options) {
+		o.Name = name
+	}
+}
+
+// WithJitter는 매 실행 전 최대 d만큼의 임의 지연을 더합니다.
+func WithJitter(d time.Duration) Option {
+	return func(o *Options) {
+		o.Jitter = d
+	}
+}
+
+// WithTimeout은 한 번의 실행 시간을 d로 제한합니다.
+func WithTimeout(d time.Duration) Option {
+	return func(o *Options) {
+		o.Timeout = d
+	}
+}
+
+// WithInterceptors는 작업 실행에 Interceptor를 적용합니다.
+func WithInterceptors(interceptors ...core.Interceptor) Option {
+	return func(o *Options) {
+		o.Interceptors = append(o.Interceptors, interceptors...)
+	}
+}
@@ -0,0 +1,337 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+/*
+Validator는 태그로 표현하기 어려운 검증이 필요한 DTO가 구현합니다.
+태그 검증이 끝난 뒤 호출되며, Errors를 반환하면 필드 에러로 그대로 합쳐집니다.
+*/
+type Validator interface {
+	Validate() error
+}
+
+// FieldError는 하나의 필드에서 실패한 검증 규칙입니다.
+type FieldError struct {
+	Field   string `json:"field,omitempty"`
+	Rule    string `json:"rule"`
+	Param   string `json:"param,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// Errors는 DTO 검증 실패 목록입니다.
+type Errors []FieldError
+
+func (e Errors) Error() string {
+	messages := make([]string, len(e))
+	for i, fieldErr := range e {
+		messages[i] = fieldErr.Error()
+	}
+	return "validation failed: " + strings.Join(messages, "; ")
+}
+
+/*
+Struct는 validate 태그와 Validator 구현을 기준으로 값을 검증합니다.
+
+지원 규칙:
+  - required      : 제로 값(빈 문자열, 0, nil 등)을 허용하지 않음
+  - omitempty     : 제로 값이면 나머지 규칙을 건너뜀
+  - min=N, max=N  : 문자열/슬라이스/맵은 길이, 숫자는 값 범위
+  - len=N         : 문자열/슬라이스/맵의 정확한 길이
+  - email         : 이메일 주소 형식
+  - oneof=a b c   : 공백으로 구분된 값 중 하나
+
+검증 실패는 Errors로, 잘못된 태그 선언은 일반 error로 반환합니다.
+*/
+func Struct(v any) error {
+	if v == nil {
+		return nil
+	}
+
+	var errs Errors
+	if err := validateValue(reflect.ValueOf(v), "", &errs); err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func validateValue(value reflect.Value, prefix string, errs *Errors) error {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+
+	typ := value.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := prefix + fieldName(field)
+		fieldValue := value.Field(i)
+
+		if tag := field.Tag.Get("validate"); tag != "" && tag != "-" {
+			if err := applyRules(fieldValue, name, tag, errs); err != nil {
+				return fmt.Errorf("invalid validate tag on %s.%s: %w", typ.Name(), field.Name, err)
+			}
+		}
+
+		// 중첩 구조체는 경로를 붙여 재귀 검증
+		if isStructLike(fieldValue.Type()) {
+			if err := validateValue(fieldValue, name+".", errs); err != nil {
+				return err
+			}
+		}
+	}
+
+	return runValidator(value, prefix, errs)
+}
+
+// runValidator는 값 또는 포인터 리시버의 Validate를 호출합니다.
+func runValidator(value reflect.Value, prefix string, errs *Errors) error {
+	var target any
+	if value.CanAddr() {
+		target = value.Addr().Interface()
+	} else {
+		target = value.Interface()
+	}
+
+	validator, ok := target.(Validator)
+	if !ok {
+		return nil
+	}
+
+	err := validator.Validate()
+	if err == nil {
+		return nil
+	}
+
+	var fieldErrs Errors
+	if errors.As(err, &fieldErrs) {
+		for _, fieldErr := range fieldErrs {
+			if prefix != "" && fieldErr.Field != "" {
+				fieldErr.Field = prefix + fieldErr.Field
+			}
+			*errs = append(*errs, fieldErr)
+		}
+		return nil
+	}
+
+	var fieldErr FieldError
+	if errors.As(err, &fieldErr) {
+		if prefix != "" && fieldErr.Field != "" {
+			fieldErr.Field = prefix + fieldErr.Field
+		}
+		*errs = append(*errs, fieldErr)
+		return nil
+	}
+
+	*errs = append(*errs, FieldError{
+		Field:   strings.TrimSuffix(prefix, "."),
+		Rule:    "validate",
+		Message: err.Error(),
+	})
+	return nil
+}
+
+func applyRules(value reflect.Value, name, tag string, errs *Errors) error {
+	rules := strings.Split(tag, ",")
+
+	for _, rule := range rules {
+		if strings.TrimSpace(rule) == "omitempty" && value.IsZero() {
+			return nil
+		}
+	}
+
+	for _, raw := range rules {
+		rule, param, _ := strings.Cut(strings.TrimSpace(raw), "=")
+		if rule == "" || rule == "omitempty" {
+			continue
+		}
+
+		if rule == "required" {
+			if value.IsZero() {
+				*errs = append(*errs, FieldError{Field: name, Rule: rule, Message: "is required"})
+				// 값이 없으면 나머지 규칙은 의미가 없다.
+				return nil
+			}
+			continue
+		}
+
+		// 필수가 아닌 nil 포인터는 검사하지 않는다.
+		target := value
+		for target.Kind() == reflect.Pointer {
+			if target.IsNil() {
+				return nil
+			}
+			target = target.Elem()
+		}
+
+		fieldErr, err := checkRule(target, rule, param)
+		if err != nil {
+			return err
+		}
+		if fieldErr != nil {
+			fieldErr.Field = name
+			*errs = append(*errs, *fieldErr)
+		}
+	}
+	return nil
+}
+
+func checkRule(value reflect.Value, rule, param string) (*FieldError, error) {
+	switch rule {
+	case "min", "max":
+		limit, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s requires a numeric parameter: %q", rule, param)
+		}
+		actual, isLength, err := measure(value, rule)
+		if err != nil {
+			return nil, err
+		}
+		if (rule == "min" && actual >= limit) || (rule == "max" && actual <= limit) {
+			return nil, nil
+		}
+		return &FieldError{Rule: rule, Param: param, Message: rangeMessage(rule, param, isLength)}, nil
+
+	case "len":
+		limit, err := strconv.Atoi(param)
+		if err != nil {
+			return nil, fmt.Errorf("len requires an integer parameter: %q", param)
+		}
+		actual, isLength, err := measure(value, rule)
+		if err != nil {
+			return nil, err
+		}
+		if !isLength {
+			return nil, fmt.Errorf("len is not supported for %s", value.Type())
+		}
+		if int(actual) == limit {
+			return nil, nil
+		}
+		return &FieldError{Rule: rule, Param: param, Message: fmt.Sprintf("must have length %s", param)}, nil
+
+	case "email":
+		if value.Kind() != reflect.String {
+			return nil, fmt.Errorf("email is not supported for %s", value.Type())
+		}
+		if isEmail(value.String()) {
+			return nil, nil
+		}
+		return &FieldError{Rule: rule, Message: "must be a valid email address"}, nil
+
+	case "oneof":
+		options := strings.Fields(param)
+		if len(options) == 0 {
+			return nil, fmt.Errorf("oneof requires at least one option")
+		}
+		actual, err := scalarString(value)
+		if err != nil {
+			return nil, err
+		}
+		for _, option := range options {
+			if option == actual {
+				return nil, nil
+			}
+		}
+		return &FieldError{Rule: rule, Param: param, Message: "must be one of [" + param + "]"}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown rule %q", rule)
+	}
+}
+
+// measure는 문자열/컬렉션은 길이, 숫자는 값을 반환합니다.
+func measure(value reflect.Value, rule string) (float64, bool, error) {
+	switch value.Kind() {
+	case reflect.String:
+		return float64(utf8.RuneCountInString(value.String())), true, nil
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(value.Len()), true, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), false, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), false, nil
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), false, nil
+	default:
+		return 0, false, fmt.Errorf("%s is not supported for %s", rule, value.Type())
+	}
+}
+
+func scalarString(value reflect.Value) (string, error) {
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("oneof is not supported for %s", value.Type())
+	}
+}
+
+func rangeMessage(rule, param string, isLength bool) string {
+	switch {
+	case rule == "min" && isLength:
+		return "must have length at least " + param
+	case rule == "min":
+		return "must be at least " + param
+	case isLength:
+		return "must have length at most " + param
+	default:
+		return "must be at most " + param
+	}
+}
+
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	if err != nil || addr.Address != s {
+		return false
+	}
+	_, domain, ok := strings.Cut(s, "@")
+	return ok && strings.Contains(domain, ".")
+}
+
+// fieldName은 응답에 노출할 필드 이름으로 json / form / query 태그를 우선 사용합니다.
+func fieldName(field reflect.StructField) string {
+	for _, key := range []string{"json", "form", "query"} {
+		name, _, _ := strings.Cut(field.Tag.Get(key), ",")
+		if name != "" && name != "-" {
+			return name
+		}
+	}
+	return field.Name
+}
+
+func isStructLike(t reflect.Type) bool {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
@@ -0,0 +1,94 @@
+package ws
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Frame은 Envelope에서 꺼낸 메시지 하나입니다.
+type Frame struct {
+	// 라우팅에 쓰는 메시지 타입
+	Type string
+	// 요청 ID. 있으면 응답과 ack에 그대로 실립니다.
+	ID string
+	// 핸들러 DTO로 바인딩되는 데이터 (JSON)
+	Data []byte
+	// 처리에 실패했을 때 응답에 담기는 에러 (JSON)
+	Error []byte
+}
+
+/*
+Envelope는 ws.On으로 등록한 경로의 메시지 형식입니다.
+수신 메시지에서 타입과 데이터를 꺼내고, 핸들러 반환값을 응답 메시지로 만듭니다.
+*/
+type Envelope interface {
+	Decode(payload []byte) (Frame, error)
+	Encode(frame Frame) ([]byte, error)
+}
+
+/*
+JSONEnvelope는 필드 이름을 지정할 수 있는 JSON 객체 형식입니다.
+비어 있는 필드 이름은 기본값을 사용합니다.
+
+	{"type": "join", "id": "1", "data": {"room": "lobby"}}
+*/
+type JSONEnvelope struct {
+	TypeField  string // 기본값 "type"
+	IDField    string // 기본값 "id"
+	DataField  string // 기본값 "data"
+	ErrorField string // 기본값 "error"
+}
+
+// DefaultEnvelope는 ws.WithEnvelope를 지정하지 않았을 때 사용하는 형식입니다.
+var DefaultEnvelope Envelope = JSONEnvelope{}
+
+func (e JSONEnvelope) Decode(payload []byte) (Frame, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return Frame{}, fmt.Errorf("ws: invalid envelope: %w", err)
+	}
+
+	var frame Frame
+	if err := json.Unmarshal(fields[e.typeField()], &frame.Type); err != nil || frame.Type == "" {
+		return Frame{}, errors.New("ws: envelope type must be a non-empty string")
+	}
+
+	if rawID, ok := fields[e.idField()]; ok {
+		// 숫자 ID도 받아 문자열로 보관한다.
+		if err := json.Unmarshal(rawID, &frame.ID); err != nil {
+			frame.ID = string(rawID)
+		}
+	}
+
+	if data, ok := fields[e.dataField()]; ok {
+		frame.Data = data
+	}
+	return frame, nil
+}
+
+func (e JSONEnvelope) Encode(frame Frame) ([]byte, error) {
+	fields := map[string]any{e.typeField(): frame.Type}
+	if frame.ID != "" {
+		fields[e.idField()] = frame.ID
+	}
+	if frame.Data != nil {
+		fields[e.dataField()] = json.RawMessage(frame.Data)
+	}
+	if frame.Error != nil {
+		fields[e.errorField()] = json.RawMessage(frame.Error)
+	}
+	return json.Marshal(fields)
+}
+
+func (e JSONEnvelope) typeField() string  { return fieldOrDefault(e.TypeField, "type") }
+func (e JSONEnvelope) idField() string    { return fieldOrDefault(e.IDField, "id") }
+func (e JSONEnvelope) dataField() string  { return fieldOrDefault(e.DataField, "data") }
+func (e JSONEnvelope) errorField() string { return fieldOrDefault(e.ErrorField, "error") }
+
+func fieldOrDefault(field, def string) string {
+	if field == "" {
+		return def
+	}
+	return field
+}
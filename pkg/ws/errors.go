@@ -0,0 +1,35 @@
+package ws
+
+import "fmt"
+
+/*
+CloseError는 연결을 닫아야 하는 에러입니다.
+핸들러나 Interceptor가 반환하면 Code와 Reason을 담은 종료 프레임을 보내고 연결을 닫습니다.
+
+	return ws.NewCloseError(ws.ClosePolicyViolation, "banned")
+
+그 외 에러는 연결을 유지한 채 에러 프레임만 보냅니다. (*httperr.HTTPError는 status, message, details를 담습니다)
+*/
+type CloseError struct {
+	// WebSocket 종료 코드 (RFC 6455)
+	Code int
+	// 종료 사유. 종료 프레임에는 123바이트까지만 실립니다.
+	Reason string
+	Cause  error
+}
+
+func NewCloseError(code int, reason string) *CloseError {
+	return &CloseError{Code: code, Reason: reason}
+}
+
+func (e *CloseError) Error() string {
+	if e.Cause != nil {
+		return fmt.Sprintf("websocket close (%d): %s: %v", e.Code, e.Reason, e.Cause)
+	}
+	return fmt.Sprintf("websocket close (%d): %s", e.Code, e.Reason)
+}
+
+// Unwrap은 errors.Is / errors.As가 원인 에러를 따라갈 수 있도록 합니다.
+func (e *CloseError) Unwrap() error {
+	return e.Cause
+}
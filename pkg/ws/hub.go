@@ -0,0 +1,61 @@
+package ws
+
+import (
+	"encoding/json"
+	"errors"
+)
+
+// ErrConnectionNotFound는 연결이 없거나 이미 끊어졌을 때 반환됩니다.
+var ErrConnectionNotFound = errors.New("websocket connection not found")
+
+/*
+Hub는 핸들러 밖에서 WebSocket 연결에 메시지를 보내는 서비스입니다.
+HTTP Controller, Event Consumer, WebSocket 핸들러 어디서든 생성자에서 주입받아 사용합니다.
+
+	func (c *ShippingConsumer) OnShipped(ctx context.Context, e OrderShipped) error {
+		return c.hub.Broadcast("user:"+e.UserID, ws.Text("shipped"))
+	}
+
+연결은 여러 방(room)에 들어갈 수 있고, 연결이 끊어지면 모든 방에서 자동으로 빠집니다.
+*/
+type Hub interface {
+	// Join은 연결을 방에 넣습니다. 없는 연결이면 ErrConnectionNotFound를 반환합니다.
+	Join(connID, room string) error
+	// Leave는 연결을 방에서 뺍니다.
+	Leave(connID, room string)
+	// Broadcast는 방의 모든 연결에 메시지를 보냅니다. 보내지 못한 연결의 에러를 모아 반환합니다.
+	Broadcast(room string, msg Message) error
+	// SendTo는 연결 하나에 메시지를 보냅니다.
+	SendTo(connID string, msg Message) error
+	// Connections는 열려 있는 모든 연결 ID를 반환합니다.
+	Connections() []string
+	// Members는 방에 들어 있는 연결 ID를 반환합니다.
+	Members(room string) []string
+	// Rooms는 연결이 들어가 있는 방 이름을 반환합니다.
+	Rooms(connID string) []string
+}
+
+// Message는 Hub로 보내는 WebSocket 메시지입니다.
+type Message struct {
+	Type int
+	Data []byte
+}
+
+// Text는 텍스트 메시지를 만듭니다.
+func Text(data string) Message {
+	return Message{Type: TextMessage, Data: []byte(data)}
+}
+
+// Binary는 바이너리 메시지를 만듭니다.
+func Binary(data []byte) Message {
+	return Message{Type: BinaryMessage, Data: data}
+}
+
+// JSON은 값을 JSON으로 직렬화한 텍스트 메시지를 만듭니다.
+func JSON(v any) (Message, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{Type: TextMessage, Data: data}, nil
+}
@@ -0,0 +1,52 @@
+package ws
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// Handshake는 연결을 연 HTTP 요청의 정보입니다. 연결마다 한 번 담아 두고, 모든 핸들러에서 받을 수 있습니다.
+type Handshake struct {
+	// 등록 경로 패턴에 매칭된 경로 파라미터 (예: /ws/rooms/:roomId)
+	Params     map[string]string
+	Header     http.Header
+	Query      url.Values
+	Cookies    []*http.Cookie
+	RemoteAddr string
+}
+
+// Cookie는 이름이 name인 쿠키를 찾습니다.
+func (h Handshake) Cookie(name string) (*http.Cookie, bool) {
+	for _, cookie := range h.Cookies {
+		if cookie.Name == name {
+			return cookie, true
+		}
+	}
+	return nil, false
+}
+
+// CloseInfo는 OnDisconnect 핸들러가 받는 연결 종료 정보입니다.
+type CloseInfo struct {
+	// WebSocket 종료 코드 (RFC 6455). 종료 프레임 없이 끊어지면 CloseAbnormalClosure입니다.
+	Code   int
+	Reason string
+}
+
+// 자주 쓰는 WebSocket 종료 코드
+const (
+	CloseNormalClosure     = 1000
+	CloseGoingAway         = 1001
+	CloseProtocolError     = 1002
+	CloseUnsupportedData   = 1003
+	CloseAbnormalClosure   = 1006
+	CloseInvalidPayload    = 1007
+	ClosePolicyViolation   = 1008
+	CloseMessageTooBig     = 1009
+	CloseInternalServerErr = 1011
+)
+
+// Attributes는 연결 단위 속성입니다. OnConnect에서 Set한 값은 이후 메시지 핸들러에서 ctx.Get으로 읽을 수 있습니다.
+type Attributes interface {
+	Set(key string, value any)
+	Get(key string) (any, bool)
+}
@@ -0,0 +1,91 @@
+package ws
+
+import "github.com/NARUBROWN/spine/core"
+
+// Options는 WebSocket 경로 등록 단위 설정입니다.
+type Options struct {
+	// 핸드셰이크 중, 업그레이드 전에 실행되는 핸들러입니다. (메서드 표현식)
+	// 에러를 반환하면 업그레이드를 거절합니다. *httperr.HTTPError면 그 상태 코드로, 그 외에는 403으로 응답합니다.
+	// Hub.Join으로 방에 넣을 수 있으며, Broadcast는 업그레이드가 끝난 뒤부터 받습니다.
+	OnConnect any
+
+	// 연결이 끊어진 뒤 실행되는 핸들러입니다. (메서드 표현식)
+	// ws.CloseInfo 파라미터로 종료 코드와 사유를 받을 수 있습니다.
+	OnDisconnect any
+
+	// OnConnect, 메시지 핸들러, OnDisconnect 실행에 적용할 Interceptor입니다. (nil 포인터는 컨테이너에서 생성)
+	Interceptors []core.Interceptor
+
+	// 메시지 타입별 핸들러입니다. 지정하면 메시지를 Envelope로 해석해 타입으로 라우팅합니다.
+	Routes []Route
+
+	// Routes의 메시지 형식입니다. nil이면 DefaultEnvelope를 사용합니다.
+	Envelope Envelope
+}
+
+// Route는 메시지 타입 하나와 핸들러(메서드 표현식)의 짝입니다.
+type Route struct {
+	Type    string
+	Handler any
+}
+
+type Option func(*Options)
+
+/*
+OnConnect는 연결을 맺기 전에 실행할 핸들러를 지정합니다.
+
+	func (c *ChatController) OnConnect(h ws.Handshake, attrs ws.Attributes) error {
+		userID, err := c.auth.Verify(h.Query.Get("token"))
+		if err != nil {
+			return httperr.Unauthorized("invalid token")
+		}
+		attrs.Set("userID", userID)
+		return nil
+	}
+
+OnConnect와 Interceptor가 ctx에 Set한 값은 연결 속성이 되어, 이후 메시지 핸들러에서 ctx.Get으로 읽을 수 있습니다.
+*/
+func OnConnect(handler any) Option {
+	return func(o *Options) {
+		o.OnConnect = handler
+	}
+}
+
+// OnDisconnect는 연결이 끊어진 뒤 실행할 핸들러를 지정합니다.
+// OnConnect가 수락한 뒤 업그레이드에 실패한 경우에도 CloseAbnormalClosure로 실행됩니다.
+func OnDisconnect(handler any) Option {
+	return func(o *Options) {
+		o.OnDisconnect = handler
+	}
+}
+
+// WithInterceptors는 이 경로의 모든 핸들러 실행에 Interceptor를 적용합니다.
+func WithInterceptors(interceptors ...core.Interceptor) Option {
+	return func(o *Options) {
+		o.Interceptors = append(o.Interceptors, interceptors...)
+	}
+}
+
+/*
+On은 메시지 타입별 핸들러를 지정합니다. 경로 하나에서 여러 타입의 메시지를 나눠 처리할 때 사용합니다.
+
+	app.WebSocket().Register("/ws/chat",
+		ws.On("join", (*ChatController).Join),
+		ws.On("message", (*ChatController).Send),
+	)
+
+핸들러의 DTO 파라미터에는 Envelope의 data가 바인딩되고, 반환값은 같은 타입과 요청 ID를 담은 응답으로 보냅니다.
+요청 ID가 있는데 반환값이 없으면 data 없이 응답(ack)합니다.
+*/
+func On(messageType string, handler any) Option {
+	return func(o *Options) {
+		o.Routes = append(o.Routes, Route{Type: messageType, Handler: handler})
+	}
+}
+
+// WithEnvelope는 ws.On 라우팅에 쓰는 메시지 형식을 지정합니다.
+func WithEnvelope(envelope Envelope) Option {
+	return func(o *Options) {
+		o.Envelope = envelope
+	}
+}
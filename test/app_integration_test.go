@@ -2,18 +2,22 @@ package test
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/NARUBROWN/spine"
+	"github.com/NARUBROWN/spine/core"
 	"github.com/NARUBROWN/spine/pkg/boot"
 	"github.com/NARUBROWN/spine/pkg/httperr"
 	"github.com/NARUBROWN/spine/pkg/httpx"
 	"github.com/NARUBROWN/spine/pkg/path"
+	"github.com/NARUBROWN/spine/pkg/route"
 )
 
 type appCtrl struct{}
@@ -22,6 +26,10 @@ func (c *appCtrl) GetUser(id path.Int) httpx.Response[int] {
 	return httpx.Response[int]{Body: int(id.Value)}
 }
 
+func (c *appCtrl) GetTenantUser(tenant path.String, id path.Int) httpx.Response[string] {
+	return httpx.Response[string]{Body: fmt.Sprintf("%s/%d", tenant.Value, id.Value)}
+}
+
 func (c *appCtrl) Hello() httpx.Response[string] {
 	return httpx.Response[string]{Body: "hello"}
 }
@@ -223,3 +231,204 @@ func TestAppIntegration_BodyLimit(t *testing.T) {
 		t.Fatalf("상태 코드는 413이어야 합니다. 실제=%d", resp.StatusCode)
 	}
 }
+
+func TestAppIntegration_OpenAPIDocument(t *testing.T) {
+	app := setupApp()
+	app.Route("DELETE", "/users/:id", (*appCtrl).Fail,
+		route.WithSummary("사용자 삭제"),
+		route.WithTags("users"),
+		route.WithResponse(http.StatusNotFound, "사용자 없음", nil),
+	)
+
+	handler := newTestHandlerFromAppWithOptions(t, app, boot.Options{
+		Address:                "127.0.0.1:0",
+		EnableGracefulShutdown: true,
+		HTTP: &boot.HTTPOptions{
+			OpenAPI: &boot.OpenAPIOptions{Path: "/docs/openapi.json", Title: "App"},
+		},
+	})
+
+	req := httptest.NewRequest("GET", "/docs/openapi.json", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("상태 코드가 잘못되었습니다: %d", rec.Code)
+	}
+
+	var served map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &served); err != nil {
+		t.Fatalf("OpenAPI 문서 파싱 실패: %v", err)
+	}
+	paths := served["paths"].(map[string]any)
+	deleteOp := paths["/users/{id}"].(map[string]any)["delete"].(map[string]any)
+	if deleteOp["summary"] != "사용자 삭제" {
+		t.Fatalf("route 옵션이 문서에 반영되어야 합니다: %v", deleteOp)
+	}
+	if _, ok := deleteOp["responses"].(map[string]any)["404"]; !ok {
+		t.Fatalf("추가 응답이 문서에 반영되어야 합니다: %v", deleteOp)
+	}
+
+	// 서버 없이도 같은 문서를 추출할 수 있어야 함
+	exported, err := app.OpenAPI(boot.HTTPOptions{OpenAPI: &boot.OpenAPIOptions{Title: "App"}})
+	if err != nil {
+		t.Fatalf("OpenAPI 추출 실패: %v", err)
+	}
+	if string(exported) != rec.Body.String() {
+		t.Fatal("제공되는 문서와 추출한 문서가 같아야 합니다")
+	}
+}
+
+func TestAppIntegration_MethodNotAllowedHeadAndOptions(t *testing.T) {
+	app := setupApp()
+	app.Route("DELETE", "/users/:id", (*appCtrl).Fail)
+	handler := newTestHandlerFromApp(t, app)
+
+	req := httptest.NewRequest(http.MethodPut, "/users/7", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("경로는 있지만 메서드가 없으면 405여야 합니다: %d", rec.Code)
+	}
+	if allow := rec.Header().Get("Allow"); allow != "DELETE, GET, HEAD, OPTIONS" {
+		t.Fatalf("Allow 헤더가 잘못되었습니다: %q", allow)
+	}
+
+	req = httptest.NewRequest(http.MethodPut, "/missing", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("없는 경로는 404여야 합니다: %d", rec.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodHead, "/users/7", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("HEAD는 GET 핸들러로 응답해야 합니다: %d", rec.Code)
+	}
+	if rec.Body.Len() != 0 {
+		t.Fatalf("HEAD 응답에는 본문이 없어야 합니다: %q", rec.Body.String())
+	}
+	if !strings.HasPrefix(rec.Header().Get("Content-Type"), "application/json") {
+		t.Fatalf("HEAD 응답은 GET과 같은 헤더를 가져야 합니다: %v", rec.Header())
+	}
+
+	req = httptest.NewRequest(http.MethodOptions, "/hello", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("OPTIONS는 204여야 합니다: %d", rec.Code)
+	}
+	if allow := rec.Header().Get("Allow"); allow != "GET, HEAD, OPTIONS" {
+		t.Fatalf("Allow 헤더가 잘못되었습니다: %q", allow)
+	}
+}
+
+type orderInterceptor struct {
+	name string
+	mu   *sync.Mutex
+	log  *[]string
+}
+
+func (i orderInterceptor) PreHandle(ctx core.ExecutionContext, meta core.HandlerMeta) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	*i.log = append(*i.log, i.name)
+	return nil
+}
+
+func (i orderInterceptor) PostHandle(ctx core.ExecutionContext, meta core.HandlerMeta) {}
+
+func (i orderInterceptor) AfterCompletion(ctx core.ExecutionContext, meta core.HandlerMeta, err error) {
+}
+
+func TestAppIntegration_RouteGroupsSharePrefixAndInterceptors(t *testing.T) {
+	var mu sync.Mutex
+	var calls []string
+	named := func(name string) orderInterceptor {
+		return orderInterceptor{name: name, mu: &mu, log: &calls}
+	}
+
+	app := setupApp()
+	admin := app.Group("/admin/", route.WithInterceptors(named("auth"), named("audit")))
+	admin.Route("GET", "", (*appCtrl).Hello)
+	reports := admin.Group("/reports", route.WithInterceptors(named("reports")))
+	reports.Route("GET", "/:id", (*appCtrl).GetUser, route.WithInterceptors(named("route")))
+
+	handler := newTestHandlerFromApp(t, app)
+
+	req := httptest.NewRequest("GET", "/admin/reports/9", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("그룹 라우트는 전체 경로로 등록되어야 합니다: %d", rec.Code)
+	}
+
+	mu.Lock()
+	got := strings.Join(calls, ",")
+	calls = nil
+	mu.Unlock()
+	if got != "auth,audit,reports,route" {
+		t.Fatalf("Interceptor는 바깥 그룹부터 순서대로 상속되어야 합니다: %s", got)
+	}
+
+	req = httptest.NewRequest("GET", "/admin", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("빈 경로는 그룹 prefix 자체로 등록되어야 합니다: %d", rec.Code)
+	}
+
+	mu.Lock()
+	got = strings.Join(calls, ",")
+	mu.Unlock()
+	if got != "auth,audit" {
+		t.Fatalf("하위 그룹 Interceptor가 상위 그룹 라우트에 섞이면 안 됩니다: %s", got)
+	}
+}
+
+func TestAppIntegration_RouteGroupRejectsInvalidPrefix(t *testing.T) {
+	for _, prefix := range []string{"api", "users/:id"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("잘못된 그룹 prefix는 선언 시점에 거부되어야 합니다: %q", prefix)
+				}
+			}()
+			spine.New().Group(prefix)
+		}()
+	}
+}
+
+func TestAppIntegration_RouteGroupAllowsConstrainedPrefix(t *testing.T) {
+	app := setupApp()
+	app.Group("/tenants/:tenant<[a-z]+>").Route("GET", "/users/:id", (*appCtrl).GetTenantUser)
+
+	handler := newTestHandlerFromApp(t, app)
+
+	req := httptest.NewRequest("GET", "/tenants/acme/users/9", nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), "acme/9") {
+		t.Fatalf("제약 조건이 있는 그룹 prefix로 라우팅되어야 합니다: %d", rec.Code)
+	}
+
+	req = httptest.NewRequest("GET", "/tenants/ACME/users/9", nil)
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusNotFound {
+		t.Fatalf("제약 조건을 만족하지 않으면 404여야 합니다: %d", rec.Code)
+	}
+}
+
+func TestAppIntegration_RouteGroupAmbiguityUsesFullPath(t *testing.T) {
+	app := spine.New()
+	app.Constructor(func() *appCtrl { return &appCtrl{} })
+	app.Route("GET", "/admin/users/me", (*appCtrl).Hello)
+	app.Group("/admin").Group("/users").Route("GET", "/:id", (*appCtrl).GetUser)
+
+	err := app.Run(boot.Options{Address: "127.0.0.1:0", HTTP: &boot.HTTPOptions{}})
+	if err == nil || !strings.Contains(err.Error(), "ambiguous route") {
+		t.Fatalf("그룹 라우트도 전체 경로로 모호성 검사를 해야 합니다: %v", err)
+	}
+}
@@ -0,0 +1,79 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/NARUBROWN/spine/pkg/event"
+	"github.com/NARUBROWN/spine/pkg/event/consume"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+func TestMemoryDedupStore_EvictsLeastRecentlyUsed(t *testing.T) {
+	ctx := context.Background()
+	store := consume.NewMemoryDedupStore(2)
+
+	for _, key := range []string{"a", "b"} {
+		if err := store.Mark(ctx, key, time.Hour); err != nil {
+			t.Fatalf("예상하지 못한 에러입니다: %v", err)
+		}
+	}
+	// a를 조회해 최근 사용으로 만든 뒤 c를 추가하면 b가 밀려난다.
+	if seen, _ := store.Seen(ctx, "a"); !seen {
+		t.Fatal("a는 기록되어 있어야 합니다")
+	}
+	if err := store.Mark(ctx, "c", time.Hour); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	if store.Len() != 2 {
+		t.Fatalf("capacity를 넘지 않아야 합니다: %d", store.Len())
+	}
+	if seen, _ := store.Seen(ctx, "b"); seen {
+		t.Fatal("가장 오래 사용하지 않은 b가 제거되어야 합니다")
+	}
+	if seen, _ := store.Seen(ctx, "a"); !seen {
+		t.Fatal("a는 유지되어야 합니다")
+	}
+}
+
+func TestMemoryDedupStore_ExpiresAfterTTL(t *testing.T) {
+	ctx := context.Background()
+	store := consume.NewMemoryDedupStore(10)
+
+	if err := store.Mark(ctx, "a", 20*time.Millisecond); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if seen, _ := store.Seen(ctx, "a"); !seen {
+		t.Fatal("TTL 이전에는 기록되어 있어야 합니다")
+	}
+
+	time.Sleep(40 * time.Millisecond)
+	if seen, _ := store.Seen(ctx, "a"); seen {
+		t.Fatal("TTL이 지나면 기록이 없어야 합니다")
+	}
+	if store.Len() != 0 {
+		t.Fatalf("만료된 키는 조회 시 제거되어야 합니다: %d", store.Len())
+	}
+}
+
+func TestDedupPolicy_KeyOf(t *testing.T) {
+	policy := consume.DedupPolicy{}
+
+	if key := policy.KeyOf(event.Metadata{MessageID: "broker-1"}, nil); key != "broker-1" {
+		t.Fatalf("브로커 메시지 ID를 우선 사용해야 합니다: %q", key)
+	}
+	metadata := event.Metadata{Headers: map[string]string{publish.MessageIDHeader: "evt-1"}}
+	if key := policy.KeyOf(metadata, nil); key != "evt-1" {
+		t.Fatalf("X-Message-Id 헤더를 사용해야 합니다: %q", key)
+	}
+
+	policy.Key = func(metadata event.Metadata, payload []byte) string { return string(payload) }
+	if key := policy.KeyOf(metadata, []byte("order-1")); key != "order-1" {
+		t.Fatalf("사용자 키 함수를 사용해야 합니다: %q", key)
+	}
+	if policy.Expiry() != 24*time.Hour {
+		t.Fatalf("기본 TTL은 24시간이어야 합니다: %v", policy.Expiry())
+	}
+}
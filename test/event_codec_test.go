@@ -0,0 +1,163 @@
+package test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/NARUBROWN/spine/pkg/event/codec"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type codecOrderCreated struct {
+	OrderID string `json:"orderId"`
+}
+
+func (e codecOrderCreated) Name() string          { return "order.created" }
+func (e codecOrderCreated) OccurredAt() time.Time { return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC) }
+func (e codecOrderCreated) Key() string           { return e.OrderID }
+func (e codecOrderCreated) EventID() string       { return "evt-1" }
+
+type codecAuditLogged struct {
+	Message string `json:"message"`
+}
+
+func (e codecAuditLogged) Name() string          { return "audit.logged" }
+func (e codecAuditLogged) OccurredAt() time.Time { return time.Time{} }
+
+// protoEvent는 proto.Message를 구현하는 도메인 이벤트입니다.
+type protoEvent struct {
+	*wrapperspb.StringValue
+}
+
+func (e protoEvent) Name() string          { return "order.tagged" }
+func (e protoEvent) OccurredAt() time.Time { return time.Time{} }
+
+func TestCodecRegistry_DefaultsToJSONAndDetectsProtobuf(t *testing.T) {
+	var nilRegistry *codec.Registry
+
+	msg, err := nilRegistry.Encode(context.Background(), codecOrderCreated{OrderID: "o-1"})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if string(msg.Body) != `{"orderId":"o-1"}` || msg.Headers[codec.ContentTypeHeader] != "application/json" {
+		t.Fatalf("기본 Codec은 JSON이어야 합니다: %s %v", msg.Body, msg.Headers)
+	}
+
+	msg, err = codec.NewRegistry(nil).Encode(context.Background(), protoEvent{wrapperspb.String("vip")})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if msg.Headers[codec.ContentTypeHeader] != "application/protobuf" {
+		t.Fatalf("proto.Message 이벤트는 Protobuf로 직렬화되어야 합니다: %v", msg.Headers)
+	}
+
+	decoded := &wrapperspb.StringValue{}
+	if err := nilRegistry.Decode("order.tagged", msg, decoded); err != nil || decoded.GetValue() != "vip" {
+		t.Fatalf("content-type으로 Protobuf Codec을 골라야 합니다: %v (%v)", decoded, err)
+	}
+}
+
+func TestCodecRegistry_SelectsCodecPerEventTypeAndTopic(t *testing.T) {
+	binary := codec.CloudEvents{Mode: codec.BinaryMode, Source: "/orders"}
+	registry := codec.NewRegistry(codec.CloudEvents{Source: "/default"}).
+		ForEvent(codecOrderCreated{}, binary).
+		ForTopic("audit.logged", codec.JSON)
+
+	if registry.Encoder(codecOrderCreated{}) != codec.Codec(binary) {
+		t.Fatal("이벤트 타입에 지정한 Codec을 사용해야 합니다")
+	}
+	if registry.Encoder(codecAuditLogged{}) != codec.JSON {
+		t.Fatal("토픽에 지정한 Codec을 사용해야 합니다")
+	}
+
+	// content-type 헤더가 없으면 토픽 Codec, 그다음 기본 Codec을 사용한다.
+	if registry.Decoder("audit.logged", codec.Message{}) != codec.JSON {
+		t.Fatal("헤더가 없으면 토픽에 지정한 Codec으로 역직렬화해야 합니다")
+	}
+	if _, ok := registry.Decoder("unknown", codec.Message{}).(codec.CloudEvents); !ok {
+		t.Fatal("헤더와 토픽 지정이 없으면 기본 Codec으로 역직렬화해야 합니다")
+	}
+}
+
+func TestCloudEvents_BinaryModeUsesCEHeaders(t *testing.T) {
+	msg, err := codec.CloudEvents{Mode: codec.BinaryMode, Source: "/orders"}.
+		Encode(context.Background(), codecOrderCreated{OrderID: "o-1"})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	want := map[string]string{
+		"content-type":   "application/json",
+		"ce-specversion": "1.0",
+		"ce-id":          "evt-1",
+		"ce-source":      "/orders",
+		"ce-type":        "order.created",
+		"ce-subject":     "o-1",
+		"ce-time":        "2024-01-02T03:04:05Z",
+	}
+	for key, value := range want {
+		if msg.Headers[key] != value {
+			t.Fatalf("%s 헤더가 잘못되었습니다: %q (전체=%v)", key, msg.Headers[key], msg.Headers)
+		}
+	}
+	if string(msg.Body) != `{"orderId":"o-1"}` {
+		t.Fatalf("바이너리 모드의 본문은 data 그대로여야 합니다: %s", msg.Body)
+	}
+
+	var decoded codecOrderCreated
+	if err := codec.NewRegistry(nil).Decode("order.created", msg, &decoded); err != nil || decoded.OrderID != "o-1" {
+		t.Fatalf("바이너리 모드 메시지를 역직렬화할 수 있어야 합니다: %+v (%v)", decoded, err)
+	}
+}
+
+func TestCloudEvents_StructuredModeRoundTrip(t *testing.T) {
+	msg, err := codec.CloudEvents{Source: "/orders"}.Encode(context.Background(), codecOrderCreated{OrderID: "o-1"})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if msg.Headers[codec.ContentTypeHeader] != codec.CloudEventsContentType {
+		t.Fatalf("구조화 모드의 content-type이 잘못되었습니다: %v", msg.Headers)
+	}
+
+	var document map[string]any
+	if err := json.Unmarshal(msg.Body, &document); err != nil {
+		t.Fatalf("구조화 모드 본문은 JSON이어야 합니다: %v", err)
+	}
+	if document["specversion"] != "1.0" || document["type"] != "order.created" || document["source"] != "/orders" ||
+		document["id"] != "evt-1" || document["datacontenttype"] != "application/json" {
+		t.Fatalf("CloudEvents 속성이 잘못되었습니다: %v", document)
+	}
+	if data, ok := document["data"].(map[string]any); !ok || data["orderId"] != "o-1" {
+		t.Fatalf("JSON data는 문서 안에 그대로 들어가야 합니다: %v", document["data"])
+	}
+
+	// 수신 측은 별도 설정 없이 content-type으로 CloudEvents를 인식한다.
+	var decoded codecOrderCreated
+	if err := codec.NewRegistry(nil).Decode("order.created", msg, &decoded); err != nil || decoded.OrderID != "o-1" {
+		t.Fatalf("구조화 모드 메시지를 역직렬화할 수 있어야 합니다: %+v (%v)", decoded, err)
+	}
+}
+
+func TestCloudEvents_StructuredModeEncodesBinaryDataAsBase64(t *testing.T) {
+	ce := codec.CloudEvents{Data: codec.Protobuf}
+	msg, err := ce.Encode(context.Background(), protoEvent{wrapperspb.String("vip")})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	var document map[string]any
+	_ = json.Unmarshal(msg.Body, &document)
+	if document["data_base64"] == nil || document["datacontenttype"] != "application/protobuf" {
+		t.Fatalf("바이너리 data는 data_base64로 들어가야 합니다: %v", document)
+	}
+
+	decoded := &wrapperspb.StringValue{}
+	if err := codec.NewRegistry(nil).Decode("order.tagged", msg, decoded); err != nil || decoded.GetValue() != "vip" {
+		t.Fatalf("datacontenttype으로 data Codec을 골라야 합니다: %v (%v)", decoded, err)
+	}
+}
+
+var _ publish.Keyed = codecOrderCreated{}
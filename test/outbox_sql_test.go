@@ -0,0 +1,381 @@
+package test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/NARUBROWN/spine/pkg/event/outbox"
+	"github.com/NARUBROWN/spine/pkg/event/publish"
+)
+
+// fakeOutboxDB는 실행된 쿼리를 기록하고, 조회 시 미리 지정한 행을 돌려주는 database/sql 드라이버입니다.
+type fakeOutboxDB struct {
+	mu      sync.Mutex
+	execs   []fakeExec
+	queries []fakeExec
+	rows    [][]driver.Value
+}
+
+type fakeExec struct {
+	query string
+	args  []driver.Value
+	inTx  bool
+}
+
+type fakeOutboxConn struct {
+	db   *fakeOutboxDB
+	inTx bool
+	// 트랜잭션 안에서 실행되어 커밋을 기다리는 쿼리
+	txExecs []fakeExec
+}
+
+func (c *fakeOutboxConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+
+func (c *fakeOutboxConn) Close() error { return nil }
+
+func (c *fakeOutboxConn) Begin() (driver.Tx, error) {
+	c.inTx = true
+	return &fakeOutboxTx{conn: c}, nil
+}
+
+func (c *fakeOutboxConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	exec := fakeExec{query: query, args: values, inTx: c.inTx}
+	if c.inTx {
+		c.txExecs = append(c.txExecs, exec)
+	} else {
+		c.db.execs = append(c.db.execs, exec)
+	}
+	return driver.RowsAffected(2), nil
+}
+
+func (c *fakeOutboxConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.db.mu.Lock()
+	defer c.db.mu.Unlock()
+
+	values := make([]driver.Value, len(args))
+	for i, arg := range args {
+		values[i] = arg.Value
+	}
+	c.db.queries = append(c.db.queries, fakeExec{query: query, args: values})
+	return &fakeOutboxRows{rows: c.db.rows}, nil
+}
+
+type fakeOutboxTx struct {
+	conn *fakeOutboxConn
+}
+
+func (tx *fakeOutboxTx) Commit() error {
+	tx.conn.db.mu.Lock()
+	defer tx.conn.db.mu.Unlock()
+
+	tx.conn.db.execs = append(tx.conn.db.execs, tx.conn.txExecs...)
+	tx.conn.txExecs = nil
+	tx.conn.inTx = false
+	return nil
+}
+
+func (tx *fakeOutboxTx) Rollback() error {
+	tx.conn.txExecs = nil
+	tx.conn.inTx = false
+	return nil
+}
+
+type fakeOutboxRows struct {
+	rows [][]driver.Value
+	next int
+}
+
+func (r *fakeOutboxRows) Columns() []string {
+	return []string{"id", "aggregate_id", "event_name", "payload", "headers", "occurred_at", "created_at", "deliver_at"}
+}
+
+func (r *fakeOutboxRows) Close() error { return nil }
+
+func (r *fakeOutboxRows) Next(dest []driver.Value) error {
+	if r.next >= len(r.rows) {
+		return io.EOF
+	}
+	copy(dest, r.rows[r.next])
+	r.next++
+	return nil
+}
+
+var registerFakeOutboxDriver sync.Once
+
+func openFakeOutboxDB(t *testing.T) (*sql.DB, *fakeOutboxDB) {
+	t.Helper()
+
+	fake := &fakeOutboxDB{}
+	registerFakeOutboxDriver.Do(func() {
+		sql.Register("spine-fake-outbox", &fakeOutboxDriver{})
+	})
+	fakeOutboxDrivers.Store(t.Name(), fake)
+
+	db, err := sql.Open("spine-fake-outbox", t.Name())
+	if err != nil {
+		t.Fatalf("fake DB를 열 수 없습니다: %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db, fake
+}
+
+// fakeOutboxDriver는 DSN(테스트 이름)으로 테스트별 fakeOutboxDB를 찾습니다.
+type fakeOutboxDriver struct{}
+
+var fakeOutboxDrivers sync.Map
+
+func (fakeOutboxDriver) Open(name string) (driver.Conn, error) {
+	db, ok := fakeOutboxDrivers.Load(name)
+	if !ok {
+		return nil, errors.New("unknown fake DB: " + name)
+	}
+	return &fakeOutboxConn{db: db.(*fakeOutboxDB)}, nil
+}
+
+type outboxOrderCreated struct {
+	OrderID string `json:"orderId"`
+}
+
+func (e outboxOrderCreated) Name() string          { return "order.created" }
+func (e outboxOrderCreated) OccurredAt() time.Time { return time.Unix(100, 0) }
+func (e outboxOrderCreated) Key() string           { return e.OrderID }
+func (e outboxOrderCreated) EventID() string       { return "evt-" + e.OrderID }
+
+func TestSQLStore_AppendWritesInCallerTransaction(t *testing.T) {
+	db, fake := openFakeOutboxDB(t)
+	store, err := outbox.NewSQLStore(db, outbox.SQLOptions{Placeholder: outbox.DollarPlaceholder})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	ctx := context.Background()
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if err := outbox.Append(outbox.WithTx(ctx, tx), store, outboxOrderCreated{OrderID: "o-1"}); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	if len(fake.execs) != 1 {
+		t.Fatalf("INSERT 한 번이 실행되어야 합니다: %v", fake.execs)
+	}
+	exec := fake.execs[0]
+	if !exec.inTx {
+		t.Fatal("호출자의 트랜잭션 안에서 기록되어야 합니다")
+	}
+	if !strings.HasPrefix(exec.query, "INSERT INTO spine_outbox") || !strings.Contains(exec.query, "$7") {
+		t.Fatalf("INSERT 쿼리가 잘못되었습니다: %s", exec.query)
+	}
+	if exec.args[0] != "o-1" || exec.args[1] != "order.created" || string(exec.args[2].([]byte)) != `{"orderId":"o-1"}` {
+		t.Fatalf("기록된 값이 잘못되었습니다: %v", exec.args)
+	}
+	if exec.args[3] != `{"X-Message-Id":"evt-o-1","content-type":"application/json"}` {
+		t.Fatalf("헤더는 JSON으로 기록되고 메시지 ID가 고정되어야 합니다: %v", exec.args[3])
+	}
+	if exec.args[6] != nil {
+		t.Fatalf("즉시 발행할 이벤트는 deliver_at이 NULL이어야 합니다: %v", exec.args[6])
+	}
+}
+
+func TestSQLStore_AppendRecordsDeliverAtForDelayedEvents(t *testing.T) {
+	db, fake := openFakeOutboxDB(t)
+	store, err := outbox.NewSQLStore(db, outbox.SQLOptions{})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	deliverAt := time.Now().Add(30 * time.Minute)
+	if err := outbox.Append(context.Background(), store, publish.DelayUntil(outboxOrderCreated{OrderID: "o-1"}, deliverAt)); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	exec := fake.execs[0]
+	if exec.args[1] != "order.created" || string(exec.args[2].([]byte)) != `{"orderId":"o-1"}` {
+		t.Fatalf("감싼 이벤트가 기록되어야 합니다: %v", exec.args)
+	}
+	if got, ok := exec.args[6].(time.Time); !ok || !got.Equal(deliverAt) {
+		t.Fatalf("deliver_at이 기록되어야 합니다: %v", exec.args[6])
+	}
+}
+
+func TestSQLStore_PendingMarkSentAndDeleteSent(t *testing.T) {
+	db, fake := openFakeOutboxDB(t)
+	store, err := outbox.NewSQLStore(db, outbox.SQLOptions{Table: "events_outbox"})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	occurredAt := time.Unix(100, 0)
+	fake.rows = [][]driver.Value{
+		{int64(1), "o-1", "order.created", []byte(`{"orderId":"o-1"}`), `{"X-Correlation-Id":"corr-1"}`, occurredAt, occurredAt, nil},
+		{int64(2), "o-1", "order.paid", []byte(`{"orderId":"o-1"}`), "{}", occurredAt, occurredAt, occurredAt},
+	}
+
+	records, err := store.Pending(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if len(records) != 2 || records[0].ID != 1 || records[1].EventName != "order.paid" {
+		t.Fatalf("조회된 레코드가 잘못되었습니다: %v", records)
+	}
+	if records[0].Headers["X-Correlation-Id"] != "corr-1" || records[1].Headers != nil {
+		t.Fatalf("헤더가 복원되어야 합니다: %v, %v", records[0].Headers, records[1].Headers)
+	}
+	if records[0].DeliverAt != nil || records[1].DeliverAt == nil || !records[1].DeliverAt.Equal(occurredAt) {
+		t.Fatalf("deliver_at이 복원되어야 합니다: %v, %v", records[0].DeliverAt, records[1].DeliverAt)
+	}
+
+	if err := store.MarkSent(context.Background(), 1, 2); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	update := fake.execs[0]
+	if update.query != "UPDATE events_outbox SET sent_at = ? WHERE id IN (?, ?)" || update.args[1] != int64(1) || update.args[2] != int64(2) {
+		t.Fatalf("UPDATE 쿼리가 잘못되었습니다: %s %v", update.query, update.args)
+	}
+
+	deleted, err := store.DeleteSent(context.Background(), time.Now())
+	if err != nil || deleted != 2 {
+		t.Fatalf("삭제 개수가 반환되어야 합니다: %d (%v)", deleted, err)
+	}
+	if !strings.HasPrefix(fake.execs[1].query, "DELETE FROM events_outbox WHERE sent_at IS NOT NULL") {
+		t.Fatalf("DELETE 쿼리가 잘못되었습니다: %s", fake.execs[1].query)
+	}
+}
+
+// outboxTestBus는 컨트롤러가 주입받는 context의 이벤트 버스 역할을 합니다.
+type outboxTestBus struct {
+	events []publish.DomainEvent
+}
+
+func (b *outboxTestBus) Publish(events ...publish.DomainEvent) {
+	b.events = append(b.events, events...)
+}
+
+func (b *outboxTestBus) Drain() []publish.DomainEvent {
+	events := b.events
+	b.events = nil
+	return events
+}
+
+func TestSQLStore_FlushWritesBusEventsInCallerTransaction(t *testing.T) {
+	db, fake := openFakeOutboxDB(t)
+	store, err := outbox.NewSQLStore(db, outbox.SQLOptions{})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	bus := &outboxTestBus{}
+	ctx := context.WithValue(context.Background(), publish.PublisherKey, bus)
+
+	// 롤백된 트랜잭션의 이벤트는 Outbox에 남지 않아야 함
+	publish.Event(ctx, outboxOrderCreated{OrderID: "o-1"})
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if err := outbox.Flush(outbox.WithTx(ctx, tx), store); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if err := tx.Rollback(); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if len(fake.execs) != 0 {
+		t.Fatalf("롤백된 트랜잭션의 Outbox 레코드가 남으면 안 됩니다: %v", fake.execs)
+	}
+	if len(bus.events) != 0 {
+		t.Fatalf("Flush한 이벤트는 버스에서 빠져야 합니다: %v", bus.events)
+	}
+
+	publish.Event(ctx, outboxOrderCreated{OrderID: "o-2"})
+	tx, err = db.BeginTx(ctx, nil)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if err := outbox.Flush(outbox.WithTx(ctx, tx), store); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if len(fake.execs) != 1 || !fake.execs[0].inTx || fake.execs[0].args[0] != "o-2" {
+		t.Fatalf("커밋된 트랜잭션의 이벤트만 기록되어야 합니다: %v", fake.execs)
+	}
+}
+
+func TestSQLStore_PendingExcludesBlockedAggregates(t *testing.T) {
+	db, fake := openFakeOutboxDB(t)
+	store, err := outbox.NewSQLStore(db, outbox.SQLOptions{Placeholder: outbox.DollarPlaceholder})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	if _, err := store.Pending(context.Background(), 10, "o-1", "o-2"); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	query := fake.queries[0]
+	if !strings.Contains(query.query, "AND aggregate_id NOT IN ($2, $3) ORDER BY id LIMIT $4") {
+		t.Fatalf("제외할 aggregate가 쿼리에 포함되어야 합니다: %s", query.query)
+	}
+	if query.args[1] != "o-1" || query.args[2] != "o-2" || query.args[3] != int64(10) {
+		t.Fatalf("바인드 값이 잘못되었습니다: %v", query.args)
+	}
+}
+
+func TestTx_CommitsOnSuccessAndRollsBackOnError(t *testing.T) {
+	db, fake := openFakeOutboxDB(t)
+	store, err := outbox.NewSQLStore(db, outbox.SQLOptions{})
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	ctx := context.Background()
+
+	// 사용하지 않은 Tx는 트랜잭션을 열지 않는다.
+	if err := outbox.NewTx(ctx, db).Dispose(nil); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+
+	failed := outbox.NewTx(ctx, db)
+	if err := outbox.Append(outbox.WithTx(ctx, failed), store, outboxOrderCreated{OrderID: "o-1"}); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if err := failed.Dispose(errors.New("handler failed")); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if len(fake.execs) != 0 {
+		t.Fatalf("실행이 실패하면 Outbox 레코드도 롤백되어야 합니다: %v", fake.execs)
+	}
+
+	succeeded := outbox.NewTx(ctx, db)
+	if err := outbox.Append(outbox.WithTx(ctx, succeeded), store, outboxOrderCreated{OrderID: "o-2"}); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if err := succeeded.Dispose(nil); err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	if len(fake.execs) != 1 || !fake.execs[0].inTx || fake.execs[0].args[0] != "o-2" {
+		t.Fatalf("실행이 성공하면 Outbox 레코드가 커밋되어야 합니다: %v", fake.execs)
+	}
+	if _, err := succeeded.ExecContext(ctx, "SELECT 1"); err == nil {
+		t.Fatal("끝난 Tx는 다시 사용할 수 없어야 합니다")
+	}
+}
@@ -0,0 +1,84 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NARUBROWN/spine/pkg/schedule"
+)
+
+func mustParseSchedule(t *testing.T, spec string) schedule.Schedule {
+	t.Helper()
+
+	s, err := schedule.Parse(spec)
+	if err != nil {
+		t.Fatalf("예상하지 못한 에러입니다: %v", err)
+	}
+	return s
+}
+
+func TestScheduleParse_Next(t *testing.T) {
+	base := time.Date(2026, time.March, 14, 10, 7, 30, 0, time.UTC) // 토요일
+
+	cases := []struct {
+		spec string
+		want time.Time
+	}{
+		{"*/5 * * * *", time.Date(2026, time.March, 14, 10, 10, 0, 0, time.UTC)},
+		{"0 */5 * * *", time.Date(2026, time.March, 14, 15, 0, 0, 0, time.UTC)},
+		{"*/15 * * * * *", time.Date(2026, time.March, 14, 10, 7, 45, 0, time.UTC)},
+		{"30 0 9 * * MON-FRI", time.Date(2026, time.March, 16, 9, 0, 30, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 12 * JAN,jun 7", time.Date(2026, time.June, 7, 12, 0, 0, 0, time.UTC)},
+		{"0 8 13 * 5", time.Date(2026, time.March, 20, 8, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2026, time.March, 14, 11, 0, 0, 0, time.UTC)},
+		{"@weekly", time.Date(2026, time.March, 15, 0, 0, 0, 0, time.UTC)},
+		{"@every 90s", base.Add(90 * time.Second)},
+	}
+
+	for _, tc := range cases {
+		got := mustParseSchedule(t, tc.spec).Next(base)
+		if !got.Equal(tc.want) {
+			t.Errorf("%q: 예상=%v, 실제=%v", tc.spec, tc.want, got)
+		}
+	}
+}
+
+func TestScheduleParse_DayOfMonthAndWeekdayAreOred(t *testing.T) {
+	// 13일 또는 금요일
+	s := mustParseSchedule(t, "0 0 13 * FRI")
+
+	got := s.Next(time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2026, time.March, 13, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Fatalf("예상=%v, 실제=%v", want, got)
+	}
+	got = s.Next(got)
+	if want := time.Date(2026, time.March, 20, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Fatalf("예상=%v, 실제=%v", want, got)
+	}
+}
+
+func TestScheduleParse_ImpossibleDateHasNoNextRun(t *testing.T) {
+	s := mustParseSchedule(t, "0 0 30 2 *")
+	if next := s.Next(time.Now()); !next.IsZero() {
+		t.Fatalf("2월 30일은 실행 시각이 없어야 합니다: %v", next)
+	}
+}
+
+func TestScheduleParse_RejectsInvalidSpecs(t *testing.T) {
+	for _, spec := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* * * 13 *",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"@fortnightly",
+		"@every -1s",
+		"@every soon",
+	} {
+		if _, err := schedule.Parse(spec); err == nil {
+			t.Errorf("%q: 에러가 발생해야 합니다", spec)
+		}
+	}
+}